@@ -112,7 +112,7 @@ func Middleware() func(http.Handler) http.Handler {
 			ctx := context.WithValue(r.Context(), requestCtxKey, request)
 
 			// Add user to request context
-			ctx = context.WithValue(ctx, userCtxKey, user)
+			ctx = WithUser(ctx, user)
 			r = r.WithContext(ctx)
 			next.ServeHTTP(w, r)
 		})
@@ -125,6 +125,11 @@ func GetRequestFromContext(ctx context.Context) *Request {
 	return request
 }
 
+// WithUser adds a user to a context, where GetUserFromContext finds it. Middleware does this for the user of each request.
+func WithUser(ctx context.Context, user *AuthUser) context.Context {
+	return context.WithValue(ctx, userCtxKey, user)
+}
+
 // GetUserFromContext finds the user from the context. REQUIRES Middleware to have already run.
 func GetUserFromContext(ctx context.Context) *AuthUser {
 	user, _ := ctx.Value(userCtxKey).(*AuthUser)
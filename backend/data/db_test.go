@@ -0,0 +1,81 @@
+package data
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/db"
+)
+
+// The file table as it was before the first migration
+const baseFileTable = `CREATE TABLE file (
+    id TEXT PRIMARY KEY,
+    title TEXT NOT NULL,
+    contents TEXT NOT NULL,
+    snapshot_timestamp TIMESTAMPTZ NOT NULL
+);`
+
+// Points db.DB at a new, empty schema in the database at TEST_DATABASE_URL, with every migration applied. The schema is dropped
+// when the test finishes. Tests that call this are skipped when TEST_DATABASE_URL is not set.
+func newTestDB(t *testing.T) {
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	admin, err := sql.Open("pgx", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Close() })
+
+	schema := fmt.Sprintf("sop_test_%d", time.Now().UnixNano())
+	if _, err := admin.Exec("CREATE SCHEMA " + schema + ";"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec("DROP SCHEMA " + schema + " CASCADE;"); err != nil {
+			t.Errorf("could not drop the test schema %s: %v", schema, err)
+		}
+	})
+
+	// Every connection in the pool uses the new schema
+	config, err := pgx.ParseConfig(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.RuntimeParams["search_path"] = schema
+	conn := stdlib.OpenDB(*config)
+	t.Cleanup(func() { conn.Close() })
+
+	if _, err := conn.Exec(baseFileTable); err != nil {
+		t.Fatal(err)
+	}
+
+	migrations, err := filepath.Glob(filepath.Join("..", "db", "migrations", "*.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(migrations)
+	for _, migration := range migrations {
+		statements, err := os.ReadFile(migration)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := conn.Exec(string(statements)); err != nil {
+			t.Fatalf("could not apply %s: %v", migration, err)
+		}
+	}
+
+	previous := db.DB
+	db.DB = conn
+	t.Cleanup(func() { db.DB = previous })
+}
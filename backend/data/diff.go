@@ -0,0 +1,216 @@
+package data
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// The version of a file that refers to the text saved in the search cache
+const snapshotVersion = "snapshot"
+
+// The largest number of token comparisons made when diffing. Larger changes are shown as the old text being replaced by the new
+// text, so diffing very different documents cannot use too much memory.
+const maxDiffComparisons = 4_000_000
+
+// Matches the words of a line and the whitespace between them
+var diffWords = regexp.MustCompile(`\s+|\S+`)
+
+// Compares the text of two versions of a file. Each version is either snapshotVersion for the latest text saved in the search
+// cache, snapshotVersion followed by a colon and a version number for an earlier snapshot, or the ID of a revision.
+func (s *FileService) DiffFile(ctx context.Context, id string, from string, to string) (*model.FileDiff, error) {
+	fromText, err := s.getVersionText(ctx, id, from)
+	if err != nil {
+		return nil, err
+	}
+
+	toText, err := s.getVersionText(ctx, id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FileDiff{
+		FileID:   id,
+		From:     from,
+		To:       to,
+		Segments: diffText(fromText, toText),
+	}, nil
+}
+
+// Gets the plain text of a version of a file
+func (s *FileService) getVersionText(ctx context.Context, id string, version string) (string, error) {
+	if version == snapshotVersion {
+		text, err := s.getCachedFileText(ctx, id)
+		if err != nil {
+			return "", err
+		} else if text == nil {
+			return "", errors.NewNotFoundError(ctx, "Oops! This file has not been saved in the search cache yet.")
+		}
+
+		return *text, nil
+	}
+
+	if number, ok := parseSnapshotVersion(version); ok {
+		text, err := s.getSnapshotText(ctx, id, number)
+		if err != nil {
+			return "", err
+		} else if text == nil {
+			return "", errors.NewNotFoundError(ctx, "Oops! This snapshot does not exist.")
+		}
+
+		return *text, nil
+	}
+
+	file, err := s.GetFileRevision(ctx, id, version)
+	if err != nil {
+		return "", err
+	}
+
+	text, err := s.getFileText(ctx, file)
+	if err != nil {
+		return "", sourceError(ctx, "An unexpected error occurred while retrieving a revision.", err)
+	}
+
+	return *text, nil
+}
+
+// The change to a single token
+type diffOp struct {
+	segmentType model.DiffSegmentType
+	text        string
+}
+
+// Diffs two texts line by line. Where lines were replaced, the words of the old and new lines are diffed, so only the words that
+// changed are marked as deleted and inserted.
+func diffText(from string, to string) []*model.DiffSegment {
+	lineOps := diffTokens(strings.SplitAfter(from, "\n"), strings.SplitAfter(to, "\n"))
+
+	ops := []diffOp{}
+	deleted := &strings.Builder{}
+	inserted := &strings.Builder{}
+
+	flush := func() {
+		switch {
+		case deleted.Len() > 0 && inserted.Len() > 0:
+			ops = append(ops, diffTokens(diffWords.FindAllString(deleted.String(), -1), diffWords.FindAllString(inserted.String(), -1))...)
+		case deleted.Len() > 0:
+			ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeDeleted, text: deleted.String()})
+		case inserted.Len() > 0:
+			ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeInserted, text: inserted.String()})
+		}
+		deleted.Reset()
+		inserted.Reset()
+	}
+
+	for _, op := range lineOps {
+		switch op.segmentType {
+		case model.DiffSegmentTypeDeleted:
+			deleted.WriteString(op.text)
+		case model.DiffSegmentTypeInserted:
+			inserted.WriteString(op.text)
+		default:
+			flush()
+			ops = append(ops, op)
+		}
+	}
+	flush()
+
+	// Join neighboring tokens of the same type into a single segment
+	segments := []*model.DiffSegment{}
+	for _, op := range ops {
+		if op.text == "" {
+			continue
+		}
+
+		if last := len(segments) - 1; last >= 0 && segments[last].Type == op.segmentType {
+			segments[last].Text += op.text
+		} else {
+			segments = append(segments, &model.DiffSegment{Type: op.segmentType, Text: op.text})
+		}
+	}
+
+	return segments
+}
+
+// Finds the smallest set of deleted and inserted tokens that turns a into b, using the longest common subsequence of the tokens.
+// Deletions are listed before insertions wherever both happen at the same place.
+func diffTokens(a []string, b []string) []diffOp {
+	// Tokens at the start and end that did not change do not need to be compared
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := []diffOp{}
+	for _, token := range a[:prefix] {
+		ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeUnchanged, text: token})
+	}
+
+	changedA := a[prefix : len(a)-suffix]
+	changedB := b[prefix : len(b)-suffix]
+
+	if len(changedA)*len(changedB) > maxDiffComparisons {
+		ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeDeleted, text: strings.Join(changedA, "")})
+		ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeInserted, text: strings.Join(changedB, "")})
+	} else {
+		ops = append(ops, diffLCS(changedA, changedB)...)
+	}
+
+	for _, token := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeUnchanged, text: token})
+	}
+
+	return ops
+}
+
+// Diffs two lists of tokens with a longest common subsequence table
+func diffLCS(a []string, b []string) []diffOp {
+	// lengths[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	ops := []diffOp{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeUnchanged, text: a[i]})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeDeleted, text: a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeInserted, text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeDeleted, text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{segmentType: model.DiffSegmentTypeInserted, text: b[j]})
+	}
+
+	return ops
+}
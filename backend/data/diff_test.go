@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Formats diff segments as a string, such as "=Spin at |-3000|+4000|= rpm", so they are easy to compare
+func formatSegments(segments []*model.DiffSegment) string {
+	marks := map[model.DiffSegmentType]string{model.DiffSegmentTypeUnchanged: "=", model.DiffSegmentTypeDeleted: "-", model.DiffSegmentTypeInserted: "+"}
+	parts := []string{}
+	for _, segment := range segments {
+		parts = append(parts, marks[segment.Type]+segment.Text)
+	}
+	return strings.Join(parts, "|")
+}
+
+func TestDiffText(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected string
+	}{
+		{name: "unchanged", from: "Wear gloves\n", to: "Wear gloves\n", expected: "=Wear gloves\n"},
+		{name: "changed word", from: "Title\nSpin at 3000 rpm\nDone\n", to: "Title\nSpin at 4000 rpm\nDone\n", expected: "=Title\nSpin at |-3000|+4000|= rpm\nDone\n"},
+		{name: "inserted line", from: "Step 1\nStep 3\n", to: "Step 1\nStep 2\nStep 3\n", expected: "=Step 1\n|+Step 2\n|=Step 3\n"},
+		{name: "deleted line", from: "Step 1\nStep 2\nStep 3\n", to: "Step 1\nStep 3\n", expected: "=Step 1\n|-Step 2\n|=Step 3\n"},
+		{name: "from empty", from: "", to: "New SOP", expected: "+New SOP"},
+		{name: "to empty", from: "Old SOP", to: "", expected: "-Old SOP"},
+	}
+
+	for _, test := range tests {
+		if got := formatSegments(diffText(test.from, test.to)); got != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestDiffTokensRebuildsBothTexts(t *testing.T) {
+	a := strings.Split("a b c a b b a", " ")
+	b := strings.Split("c b a b a c", " ")
+
+	from, to := []string{}, []string{}
+	for _, op := range diffTokens(a, b) {
+		if op.segmentType != model.DiffSegmentTypeInserted {
+			from = append(from, op.text)
+		}
+		if op.segmentType != model.DiffSegmentTypeDeleted {
+			to = append(to, op.text)
+		}
+	}
+
+	if !reflect.DeepEqual(from, a) || !reflect.DeepEqual(to, b) {
+		t.Errorf("expected the diff to rebuild both lists, got %v and %v", from, to)
+	}
+}
+
+func TestDiffFileBetweenRevisions(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddDocument("root", "doc", "Centrifuge", "2023-03-01T00:00:00.000Z")
+	addRevision(drive, "doc", "1", "2023-01-01T00:00:00.000Z", "Alice", "<p>Balance the rotor</p>\n<p>Spin at 3000 rpm</p>", "")
+	addRevision(drive, "doc", "2", "2023-03-01T00:00:00.000Z", "Bob", "<p>Balance the rotor</p>\n<p>Spin at 4000 rpm</p>", "")
+	service := newTestFileService(drive)
+
+	diff, err := service.DiffFile(context.Background(), "doc", "1", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff.FileID != "doc" || diff.From != "1" || diff.To != "2" {
+		t.Errorf("unexpected diff %#v", diff)
+	}
+	if got := formatSegments(diff.Segments); got != "=Balance the rotor\nSpin at |-3000|+4000|= rpm" {
+		t.Errorf("unexpected segments %q", got)
+	}
+
+	_, err = service.DiffFile(context.Background(), "doc", "1", "missing")
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 404 {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/auth"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/models"
+	"github.com/gorilla/mux"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// The mime type and file extension of a download format
+type downloadFormat struct {
+	mimeType  string
+	extension string
+}
+
+var downloadFormats = map[model.DownloadFormat]downloadFormat{
+	model.DownloadFormatPDF:  {mimeType: pdfMimeType, extension: ".pdf"},
+	model.DownloadFormatDocx: {mimeType: docxMimeType, extension: ".docx"},
+	model.DownloadFormatMd:   {mimeType: markdownMimeType, extension: ".md"},
+	model.DownloadFormatTxt:  {mimeType: textMimeType, extension: ".txt"},
+}
+
+// Gets the content of a file in the given download format, optionally from a revision
+func (s *FileService) DownloadFile(ctx context.Context, id string, revisionId *string, format model.DownloadFormat) (*models.FileDownload, error) {
+	downloadFormat, ok := downloadFormats[format]
+	if !ok {
+		return nil, errors.NewInputError(ctx, "Files cannot be downloaded in this format.")
+	}
+
+	file, err := s.getFileAtRevision(ctx, id, revisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.convertFile(ctx, file, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.FileDownload{
+		FileName: downloadFileName(file.Name, downloadFormat.extension),
+		MimeType: downloadFormat.mimeType,
+		Content:  content,
+	}, nil
+}
+
+// Gets the name of a downloaded file. Files such as PDFs and uploaded DOCX files often already have the extension in their name, so
+// it is only added when it is missing.
+func downloadFileName(name string, extension string) string {
+	if strings.HasSuffix(strings.ToLower(name), extension) {
+		return name
+	}
+
+	return name + extension
+}
+
+// Converts a file to a download format. Files that are already in the format are downloaded as is, and the source's export formats are
+// used where possible. Otherwise Markdown is converted from the HTML export, and plain text is the text that is saved in the search cache.
+func (s *FileService) convertFile(ctx context.Context, file *model.File, format model.DownloadFormat) ([]byte, error) {
+	mimeType := downloadFormats[format].mimeType
+
+	if file.MimeType == mimeType {
+		content, err := s.downloadFileContent(ctx, file)
+		if err != nil {
+			return nil, sourceError(ctx, "An unexpected error occurred while downloading a file.", err)
+		}
+
+		return content, nil
+	}
+
+	content, err := s.exportFileContent(ctx, file, mimeType)
+	if err == nil {
+		return content, nil
+	} else if err != ErrUnsupportedFormat {
+		return nil, sourceError(ctx, "An unexpected error occurred while downloading a file.", err)
+	}
+
+	switch format {
+	case model.DownloadFormatMd:
+		content, err := s.exportFileContent(ctx, file, htmlMimeType)
+		if err == nil {
+			return htmlToMarkdown(content)
+		} else if err != ErrUnsupportedFormat {
+			return nil, sourceError(ctx, "An unexpected error occurred while downloading a file.", err)
+		}
+
+		// Files without an HTML export, such as PDFs, are downloaded as plain text, which is also valid Markdown
+		fallthrough
+	case model.DownloadFormatTxt:
+		text, err := s.getFileText(ctx, file)
+		if err != nil {
+			return nil, sourceError(ctx, "An unexpected error occurred while downloading a file.", err)
+		}
+
+		return []byte(*text), nil
+	}
+
+	return nil, errors.NewInputError(ctx, fmt.Sprintf("This file cannot be downloaded as %s.", format))
+}
+
+// Serves files at /files/{id}/download?format=pdf|docx|md|txt, with an optional revision parameter. Must be used behind auth.Middleware, since only logged in users
+// can download files.
+type DownloadHandler struct {
+	FileService models.FileService
+}
+
+func (h *DownloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if auth.GetUserFromContext(r.Context()) == nil {
+		http.Error(w, "You must be logged in to download files.", http.StatusUnauthorized)
+		return
+	}
+
+	format := model.DownloadFormat(strings.ToUpper(r.URL.Query().Get("format")))
+	if !format.IsValid() {
+		http.Error(w, "The format must be one of pdf, docx, md or txt.", http.StatusBadRequest)
+		return
+	}
+
+	var revisionId *string
+	if revision := r.URL.Query().Get("revision"); revision != "" {
+		revisionId = &revision
+	}
+
+	download, err := h.FileService.DownloadFile(r.Context(), mux.Vars(r)["id"], revisionId, format)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", download.MimeType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": download.FileName}))
+	w.Write(download.Content)
+}
+
+// Responds with the message and status of an error created by the errors package. Any other error is an internal server error.
+func writeHTTPError(w http.ResponseWriter, err error) {
+	if gqlErr, ok := err.(*gqlerror.Error); ok {
+		if status, ok := gqlErr.Extensions["status"].(int); ok {
+			http.Error(w, gqlErr.Message, status)
+			return
+		}
+	}
+
+	http.Error(w, "An unexpected error occurred.", http.StatusInternalServerError)
+}
@@ -0,0 +1,124 @@
+package data
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/gorilla/mux"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestDownloadFile(t *testing.T) {
+	source := newTestLocalSource(t)
+	service := &FileService{Source: source}
+	ctx := context.Background()
+
+	tests := []struct {
+		path     string
+		format   model.DownloadFormat
+		fileName string
+		content  string
+	}{
+		{path: "Buffers/PBS.md", format: model.DownloadFormatMd, fileName: "PBS.md", content: "# PBS\n\n1. Add salt\n2. Stir & wait\n"},
+		{path: "Buffers/PBS.md", format: model.DownloadFormatTxt, fileName: "PBS.txt", content: "PBS\n\nAdd salt\nStir & wait\n"},
+		{path: "Buffers/Tris.docx", format: model.DownloadFormatMd, fileName: "Tris.md", content: "# Tris\n\nDissolve in water\n"},
+		{path: "Safety.html", format: model.DownloadFormatMd, fileName: "Safety.md", content: "Wear gloves\n"},
+	}
+
+	for _, test := range tests {
+		download, err := service.DownloadFile(ctx, source.encodeID(test.path), nil, test.format)
+		if err != nil {
+			t.Fatalf("%s as %s: %s", test.path, test.format, err)
+		}
+
+		if download.FileName != test.fileName {
+			t.Errorf("%s as %s: expected the file name %s, got %s", test.path, test.format, test.fileName, download.FileName)
+		}
+		if test.format == model.DownloadFormatMd && string(download.Content) != test.content {
+			t.Errorf("%s as %s: unexpected content %q", test.path, test.format, download.Content)
+		}
+		if test.format == model.DownloadFormatTxt && strings.Join(strings.Fields(string(download.Content)), " ") != strings.Join(strings.Fields(test.content), " ") {
+			t.Errorf("%s as %s: unexpected content %q", test.path, test.format, download.Content)
+		}
+	}
+}
+
+func TestDownloadFileName(t *testing.T) {
+	tests := []struct {
+		name      string
+		extension string
+		expected  string
+	}{
+		{"Protocol", ".pdf", "Protocol.pdf"},
+		{"Protocol.pdf", ".pdf", "Protocol.pdf"},
+		{"Protocol.PDF", ".pdf", "Protocol.PDF"},
+		{"Protocol.docx", ".docx", "Protocol.docx"},
+		{"Protocol.docx", ".md", "Protocol.docx.md"},
+		{"Protocol v1.2", ".txt", "Protocol v1.2.txt"},
+	}
+
+	for _, test := range tests {
+		if fileName := downloadFileName(test.name, test.extension); fileName != test.expected {
+			t.Errorf("expected %s as %s to be named %s, got %s", test.name, test.extension, test.expected, fileName)
+		}
+	}
+}
+
+func TestDownloadFileRejectsUnsupportedConversions(t *testing.T) {
+	source := newTestLocalSource(t)
+	service := &FileService{Source: source}
+
+	_, err := service.DownloadFile(context.Background(), source.encodeID("Safety.html"), nil, model.DownloadFormatPDF)
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 400 {
+		t.Errorf("expected an input error, got %v", err)
+	}
+
+	_, err = service.DownloadFile(context.Background(), source.encodeID("Missing.html"), nil, model.DownloadFormatTxt)
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 404 {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestDownloadHandlerRequiresLogin(t *testing.T) {
+	source := newTestLocalSource(t)
+	router := mux.NewRouter()
+	router.Handle("/files/{id}/download", &DownloadHandler{FileService: &FileService{Source: source}})
+
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/files/"+source.encodeID("Safety.html")+"/download?format=md", nil))
+
+	if res.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", res.Code)
+	}
+	if strings.Contains(res.Body.String(), "Wear gloves") {
+		t.Error("expected the file content to be withheld")
+	}
+}
+
+func TestHTMLToMarkdown(t *testing.T) {
+	document := `<html><head><style>.c1{font-weight:700}</style></head><body>
+		<h2>Preparing   <b>PBS</b></h2>
+		<p>Use <span style="font-weight: 700">10x</span> stock from <a href="https://example.com/pbs">the supplier</a>.</p>
+		<ol><li>Add salt<ul><li>NaCl_1</li></ul></li><li>Stir</li></ol>
+		<table><tr><td>Reagent</td><td>Amount</td></tr><tr><td>NaCl</td><td>8 g</td></tr></table>
+		<pre>mix --fast</pre>
+	</body></html>`
+
+	markdown, err := htmlToMarkdown([]byte(document))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "## Preparing **PBS**\n\n" +
+		"Use **10x** stock from [the supplier](https://example.com/pbs).\n\n" +
+		"1. Add salt\n   - NaCl\\_1\n2. Stir\n\n" +
+		"| Reagent | Amount |\n| --- | --- |\n| NaCl | 8 g |\n\n" +
+		"```\nmix --fast\n```\n"
+	if string(markdown) != expected {
+		t.Errorf("unexpected Markdown:\n%s\nexpected:\n%s", markdown, expected)
+	}
+}
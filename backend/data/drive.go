@@ -0,0 +1,547 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/oauth2/google"
+)
+
+// The base URL of the Google Drive API
+const defaultDriveAPIURL = "https://www.googleapis.com/drive/v2"
+
+// The base URL that file content is uploaded to
+const defaultDriveUploadURL = "https://www.googleapis.com/upload/drive/v2"
+
+// The OAuth2 scope requested for the service account. Uploading files needs write access to the root folder.
+const driveScope = "https://www.googleapis.com/auth/drive"
+
+// A DocumentSource backed by the Google Drive folder configured with ROOT_FOLDER_ID
+type DriveSource struct {
+	// The client used for every request to Drive. It is expected to add the service account's credentials to each request.
+	Client *http.Client
+
+	// The base URLs of the Drive API and of the upload endpoint. Both default to Google's servers, and can point somewhere else
+	// to use a proxy or a fake Drive in tests.
+	BaseURL   string
+	UploadURL string
+
+	// Limits how long requests can take and how failed requests are retried
+	Policy DrivePolicy
+
+	breaker circuitBreaker
+}
+
+// Creates a DriveSource that authenticates as the service account in the given JSON key file.
+// The root folder and every SOP only need to be shared with the service account's email address.
+func NewDriveSource(ctx context.Context, keyFile string) (*DriveSource, error) {
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := google.JWTConfigFromJSON(key, driveScope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DriveSource{Client: config.Client(ctx)}, nil
+}
+
+type DriveFolderQueryResponse struct {
+	Items         []*DriveFolderItem `json:"items"`
+	NextPageToken string             `json:"nextPageToken"`
+}
+
+type DriveFolderItem struct {
+	ID             string         `json:"id"`
+	Name           string         `json:"title"`
+	Type           string         `json:"mimeType"`
+	Created        string         `json:"createdDate"`
+	LastModified   string         `json:"modifiedDate"`
+	LastModifiedBy string         `json:"lastModifyingUserName"`
+	Parents        []*DriveParent `json:"parents"`
+	Labels         DriveLabels    `json:"labels"`
+	// The size of the file in bytes. Drive sends it as a string, and leaves it out for Google Docs, Sheets and Slides.
+	Size        string       `json:"fileSize"`
+	Owners      []*DriveUser `json:"owners"`
+	WebViewLink string       `json:"alternateLink"`
+	Description string       `json:"description"`
+	IconLink    string       `json:"iconLink"`
+	// Set on shortcuts to the item they point to
+	ShortcutDetails *DriveShortcutDetails `json:"shortcutDetails"`
+	// Set by the FileService on items that were listed through a shortcut
+	IsShortcut bool `json:"-"`
+}
+
+// The metadata sent when an item is created or changed. Fields that are left empty are not changed.
+type DriveItemMetadata struct {
+	Name    string         `json:"title,omitempty"`
+	Type    string         `json:"mimeType,omitempty"`
+	Parents []*DriveParent `json:"parents,omitempty"`
+}
+
+type DriveParent struct {
+	ID string `json:"id"`
+}
+
+type DriveLabels struct {
+	Trashed bool `json:"trashed"`
+	Starred bool `json:"starred"`
+}
+
+type DriveShortcutDetails struct {
+	TargetID       string `json:"targetId"`
+	TargetMimeType string `json:"targetMimeType"`
+}
+
+type DriveUser struct {
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+type DriveChangesResponse struct {
+	Items             []*DriveChange `json:"items"`
+	NextPageToken     string         `json:"nextPageToken"`
+	NewStartPageToken string         `json:"newStartPageToken"`
+}
+
+// A change to a single item, from the Drive Changes API
+type DriveChange struct {
+	FileID  string           `json:"fileId"`
+	Deleted bool             `json:"deleted"`
+	File    *DriveFolderItem `json:"file"`
+}
+
+// A notification channel, from the Drive push notifications API
+type DriveChannel struct {
+	ID         string `json:"id"`
+	ResourceID string `json:"resourceId,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Address    string `json:"address,omitempty"`
+	Token      string `json:"token,omitempty"`
+	// When the channel expires, in milliseconds since the Unix epoch
+	Expiration int64 `json:"expiration,string,omitempty"`
+}
+
+type DriveRevisionsResponse struct {
+	Items         []*DriveRevision `json:"items"`
+	NextPageToken string           `json:"nextPageToken"`
+}
+
+// A saved version of a file, from the Drive revisions API
+type DriveRevision struct {
+	ID             string `json:"id"`
+	MimeType       string `json:"mimeType"`
+	LastModified   string `json:"modifiedDate"`
+	LastModifiedBy string `json:"lastModifyingUserName"`
+	// Links to the revision in each format that Google Docs files can be exported in, keyed by mime type
+	ExportLinks map[string]string `json:"exportLinks"`
+	// A link to the content of the revision, for files that are not Google Docs files
+	DownloadURL string `json:"downloadUrl"`
+}
+
+type DriveStartPageTokenResponse struct {
+	StartPageToken string `json:"startPageToken"`
+}
+
+// Returned when Drive responds with an unsuccessful status code
+type DriveError struct {
+	StatusCode int
+	Body       string
+	// The reason Drive gave for the error, such as rateLimitExceeded
+	Reason string
+}
+
+func (e *DriveError) Error() string {
+	return fmt.Sprintf("drive responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Allows errors.Is to tell quota and permission errors apart from other errors
+func (e *DriveError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests || quotaReasons[e.Reason]:
+		return ErrDriveQuotaExceeded
+	case e.StatusCode == http.StatusForbidden || e.StatusCode == http.StatusUnauthorized:
+		return ErrDrivePermissionDenied
+	}
+
+	return nil
+}
+
+type DriveSearchQueryResponse struct {
+	Incomplete bool               `json:"incompleteSearch"`
+	Files      []*DriveSearchItem `json:"files"`
+}
+
+type DriveSearchItem struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (d *DriveSource) RootFolderID() string {
+	return os.Getenv("ROOT_FOLDER_ID")
+}
+
+// Escapes the characters that would end a string in a Drive search query early
+var driveQueryEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `'`, `\'`)
+
+// Quotes a value for a Drive search query, so it is always read as a single string no matter what it contains
+func quoteDriveQuery(value string) string {
+	return `"` + driveQueryEscaper.Replace(value) + `"`
+}
+
+// Gets every item in a Drive folder. Drive splits large result sets into pages, so this follows nextPageToken until all pages have been read.
+func (d *DriveSource) ListFolder(ctx context.Context, folderId string) ([]*DriveFolderItem, error) {
+	items := []*DriveFolderItem{}
+	pageToken := ""
+
+	for {
+		params := url.Values{}
+		params.Set("q", quoteDriveQuery(folderId)+" in parents and trashed = false")
+		params.Set("maxResults", "1000")
+		if pageToken != "" {
+			params.Set("pageToken", pageToken)
+		}
+
+		// Make a request to Google Drive API to get the next page of items in the folder
+		resBody, err := d.get(ctx, d.apiURL()+"/files?"+params.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		// Parse the JSON string response into a struct
+		data := &DriveFolderQueryResponse{}
+		err = json.Unmarshal(resBody, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, data.Items...)
+
+		if data.NextPageToken == "" {
+			return items, nil
+		}
+		pageToken = data.NextPageToken
+	}
+}
+
+func (d *DriveSource) GetItem(ctx context.Context, id string) (*DriveFolderItem, error) {
+	resBody, err := d.get(ctx, fmt.Sprintf("%s/files/%s", d.apiURL(), url.PathEscape(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the JSON string response into a struct
+	data := &DriveFolderItem{}
+	err = json.Unmarshal(resBody, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (d *DriveSource) ExportContent(ctx context.Context, id string, mimeType string) ([]byte, error) {
+	params := url.Values{}
+	params.Set("mimeType", mimeType)
+
+	resBody, err := d.get(ctx, fmt.Sprintf("%s/files/%s/export?%s", d.apiURL(), url.PathEscape(id), params.Encode()))
+
+	// Drive responds with 400 Bad Request when a Google Docs file cannot be converted to the requested type, and with a
+	// fileNotExportable error for files that are not Google Docs files
+	if driveErr, ok := err.(*DriveError); ok {
+		if driveErr.StatusCode == http.StatusBadRequest || (driveErr.StatusCode == http.StatusForbidden && strings.Contains(driveErr.Body, "fileNotExportable")) {
+			return nil, ErrUnsupportedFormat
+		}
+	}
+
+	return resBody, err
+}
+
+func (d *DriveSource) GetStartPageToken(ctx context.Context) (string, error) {
+	resBody, err := d.get(ctx, d.apiURL()+"/changes/startPageToken")
+	if err != nil {
+		return "", err
+	}
+
+	data := &DriveStartPageTokenResponse{}
+	err = json.Unmarshal(resBody, &data)
+	if err != nil {
+		return "", err
+	}
+
+	return data.StartPageToken, nil
+}
+
+// Gets every change since the given page token, following nextPageToken until all pages have been read
+func (d *DriveSource) ListChanges(ctx context.Context, pageToken string) ([]*DriveChange, string, error) {
+	changes := []*DriveChange{}
+
+	for {
+		params := url.Values{}
+		params.Set("pageToken", pageToken)
+		params.Set("includeDeleted", "true")
+		params.Set("maxResults", "1000")
+
+		resBody, err := d.get(ctx, d.apiURL()+"/changes?"+params.Encode())
+		if driveErr, ok := err.(*DriveError); ok && (driveErr.StatusCode == http.StatusBadRequest || driveErr.StatusCode == http.StatusGone) {
+			return nil, "", ErrInvalidPageToken
+		} else if err == ErrItemNotFound {
+			return nil, "", ErrInvalidPageToken
+		} else if err != nil {
+			return nil, "", err
+		}
+
+		data := &DriveChangesResponse{}
+		err = json.Unmarshal(resBody, &data)
+		if err != nil {
+			return nil, "", err
+		}
+
+		changes = append(changes, data.Items...)
+
+		if data.NextPageToken == "" {
+			return changes, data.NewStartPageToken, nil
+		}
+		pageToken = data.NextPageToken
+	}
+}
+
+func (d *DriveSource) DownloadContent(ctx context.Context, id string) ([]byte, error) {
+	params := url.Values{}
+	params.Set("alt", "media")
+
+	return d.get(ctx, fmt.Sprintf("%s/files/%s?%s", d.apiURL(), url.PathEscape(id), params.Encode()))
+}
+
+// Gets every revision of a file, following nextPageToken until all pages have been read
+func (d *DriveSource) ListRevisions(ctx context.Context, id string) ([]*DriveRevision, error) {
+	revisions := []*DriveRevision{}
+	pageToken := ""
+
+	for {
+		params := url.Values{}
+		params.Set("maxResults", "1000")
+		if pageToken != "" {
+			params.Set("pageToken", pageToken)
+		}
+
+		resBody, err := d.get(ctx, fmt.Sprintf("%s/files/%s/revisions?%s", d.apiURL(), url.PathEscape(id), params.Encode()))
+		if err != nil {
+			return nil, err
+		}
+
+		data := &DriveRevisionsResponse{}
+		err = json.Unmarshal(resBody, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, data.Items...)
+
+		if data.NextPageToken == "" {
+			return revisions, nil
+		}
+		pageToken = data.NextPageToken
+	}
+}
+
+func (d *DriveSource) GetRevision(ctx context.Context, id string, revisionId string) (*DriveRevision, error) {
+	resBody, err := d.get(ctx, fmt.Sprintf("%s/files/%s/revisions/%s", d.apiURL(), url.PathEscape(id), url.PathEscape(revisionId)))
+	if err != nil {
+		return nil, err
+	}
+
+	data := &DriveRevision{}
+	err = json.Unmarshal(resBody, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Exports a revision using the revision's export links. Revisions of files that are not Google Docs files can only be downloaded as they are.
+func (d *DriveSource) ExportRevision(ctx context.Context, id string, revisionId string, mimeType string) ([]byte, error) {
+	revision, err := d.GetRevision(ctx, id, revisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if link := revision.ExportLinks[mimeType]; link != "" {
+		return d.get(ctx, link)
+	} else if mimeType == revision.MimeType && revision.DownloadURL != "" {
+		return d.get(ctx, revision.DownloadURL)
+	}
+
+	return nil, ErrUnsupportedFormat
+}
+
+// Asks Drive to send a notification to the channel's address whenever an item changes after the given page token
+func (d *DriveSource) WatchChanges(ctx context.Context, pageToken string, channel *DriveChannel) (*DriveChannel, error) {
+	params := url.Values{}
+	params.Set("pageToken", pageToken)
+	params.Set("includeDeleted", "true")
+
+	resBody, err := d.send(ctx, http.MethodPost, d.apiURL()+"/changes/watch?"+params.Encode(), channel)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &DriveChannel{}
+	err = json.Unmarshal(resBody, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Stops notifications from being sent to a channel
+func (d *DriveSource) StopChannel(ctx context.Context, channel *DriveChannel) error {
+	_, err := d.send(ctx, http.MethodPost, d.apiURL()+"/channels/stop", &DriveChannel{ID: channel.ID, ResourceID: channel.ResourceID})
+	return err
+}
+
+// Uploads a file into a folder with a single multipart request, which sends the file's metadata and content together
+func (d *DriveSource) UploadFile(ctx context.Context, folderId string, name string, mimeType string, content io.Reader, convert bool) (*DriveFolderItem, error) {
+	metadata, err := json.Marshal(&DriveItemMetadata{Name: name, Type: mimeType, Parents: []*DriveParent{{ID: folderId}}})
+	if err != nil {
+		return nil, err
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/json; charset=UTF-8"}})
+	if err != nil {
+		return nil, err
+	}
+	part.Write(metadata)
+
+	part, err = writer.CreatePart(textproto.MIMEHeader{"Content-Type": {mimeType}})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("uploadType", "multipart")
+	params.Set("convert", strconv.FormatBool(convert))
+
+	resBody, err := d.do(ctx, http.MethodPost, d.uploadURL()+"/files?"+params.Encode(), "multipart/related; boundary="+writer.Boundary(), body.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	item := &DriveFolderItem{}
+	if err := json.Unmarshal(resBody, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// Creates a folder inside another folder
+func (d *DriveSource) CreateFolder(ctx context.Context, parentId string, name string) (*DriveFolderItem, error) {
+	return d.sendItem(ctx, http.MethodPost, d.apiURL()+"/files", &DriveItemMetadata{Name: name, Type: folderMimeType, Parents: []*DriveParent{{ID: parentId}}})
+}
+
+func (d *DriveSource) RenameItem(ctx context.Context, id string, name string) (*DriveFolderItem, error) {
+	return d.sendItem(ctx, http.MethodPatch, fmt.Sprintf("%s/files/%s", d.apiURL(), url.PathEscape(id)), &DriveItemMetadata{Name: name})
+}
+
+// Moves an item into a folder by adding the folder as a parent and removing every other parent in the same request
+func (d *DriveSource) MoveItem(ctx context.Context, id string, folderId string) (*DriveFolderItem, error) {
+	item, err := d.GetItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	parentIds := []string{}
+	for _, parent := range item.Parents {
+		if parent.ID != folderId {
+			parentIds = append(parentIds, parent.ID)
+		}
+	}
+
+	params := url.Values{}
+	params.Set("addParents", folderId)
+	if len(parentIds) > 0 {
+		params.Set("removeParents", strings.Join(parentIds, ","))
+	}
+
+	return d.sendItem(ctx, http.MethodPatch, fmt.Sprintf("%s/files/%s?%s", d.apiURL(), url.PathEscape(id), params.Encode()), &DriveItemMetadata{})
+}
+
+func (d *DriveSource) TrashItem(ctx context.Context, id string) error {
+	_, err := d.send(ctx, http.MethodPost, fmt.Sprintf("%s/files/%s/trash", d.apiURL(), url.PathEscape(id)), nil)
+	return err
+}
+
+// Makes a request with a JSON body and parses the item in the response
+func (d *DriveSource) sendItem(ctx context.Context, method string, requestURL string, body interface{}) (*DriveFolderItem, error) {
+	resBody, err := d.send(ctx, method, requestURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	item := &DriveFolderItem{}
+	if err := json.Unmarshal(resBody, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (d *DriveSource) apiURL() string {
+	if d.BaseURL == "" {
+		return defaultDriveAPIURL
+	}
+
+	return strings.TrimSuffix(d.BaseURL, "/")
+}
+
+func (d *DriveSource) uploadURL() string {
+	if d.UploadURL == "" {
+		return defaultDriveUploadURL
+	}
+
+	return strings.TrimSuffix(d.UploadURL, "/")
+}
+
+// Makes a GET request and returns the response body
+func (d *DriveSource) get(ctx context.Context, requestURL string) ([]byte, error) {
+	return d.send(ctx, http.MethodGet, requestURL, nil)
+}
+
+// Makes a request with an optional JSON body and returns the response body
+func (d *DriveSource) send(ctx context.Context, method string, requestURL string, body interface{}) ([]byte, error) {
+	if body == nil {
+		return d.do(ctx, method, requestURL, "", nil)
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.do(ctx, method, requestURL, "application/json", encoded)
+}
@@ -0,0 +1,176 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/drivetest"
+)
+
+// Starts a stand-in for Google's OAuth2 token endpoint and writes a service account key file that points to it
+func newTestServiceAccount(t *testing.T, accessToken string) (string, *int) {
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || r.Form.Get("assertion") == "" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	key, _ := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "sop-organizer@example.iam.gserviceaccount.com",
+		"private_key_id": "test-key-id",
+		"private_key":    string(keyPEM),
+		"token_uri":      server.URL,
+	})
+
+	keyFile := filepath.Join(t.TempDir(), "service-account.json")
+	if err := os.WriteFile(keyFile, key, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return keyFile, &tokenRequests
+}
+
+func TestDriveSourceAuthenticatesWithServiceAccount(t *testing.T) {
+	drive := newFakeDrive(t, 2)
+	drive.AccessToken = "service-account-token"
+	for _, name := range []string{"A", "B", "C"} {
+		drive.AddFolder("root", name, name)
+	}
+
+	keyFile, tokenRequests := newTestServiceAccount(t, drive.AccessToken)
+	source, err := NewDriveSource(context.Background(), keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	source.BaseURL = drive.URL
+
+	folders, err := (&FileService{Source: source}).GetAllFolders(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folders) != 3 {
+		t.Fatalf("expected 3 folders, got %d", len(folders))
+	}
+
+	// The token should be reused for every page
+	if *tokenRequests != 1 {
+		t.Errorf("expected 1 token request, got %d", *tokenRequests)
+	}
+}
+
+func TestNewDriveSourceRejectsInvalidKeyFile(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "service-account.json")
+	os.WriteFile(keyFile, []byte(`{"type":"authorized_user"}`), 0600)
+
+	if _, err := NewDriveSource(context.Background(), keyFile); err == nil {
+		t.Error("expected an error for a key file that is not a service account key")
+	}
+
+	if _, err := NewDriveSource(context.Background(), filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected an error for a missing key file")
+	}
+}
+
+func TestDriveSourceListsChanges(t *testing.T) {
+	drive := newFakeDrive(t, 2)
+	source := newTestDriveSource(drive)
+	ctx := context.Background()
+
+	startToken, err := source.GetStartPageToken(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		drive.AddChange(&drivetest.Change{FileID: strconv.Itoa(i), File: &drivetest.File{ID: strconv.Itoa(i)}})
+	}
+
+	changes, newToken, err := source.ListChanges(ctx, startToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 5 {
+		t.Errorf("expected 5 changes across every page, got %d", len(changes))
+	}
+
+	changes, _, err = source.ListChanges(ctx, newToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changes since the new token, got %d", len(changes))
+	}
+
+	if _, _, err := source.ListChanges(ctx, "expired"); err != ErrInvalidPageToken {
+		t.Errorf("expected ErrInvalidPageToken, got %v", err)
+	}
+}
+
+func TestQuoteDriveQuery(t *testing.T) {
+	tests := map[string]string{
+		"protocols":      `"protocols"`,
+		`say "hi"`:       `"say \"hi\""`,
+		`it's`:           `"it\'s"`,
+		`back\slash`:     `"back\\slash"`,
+		`\" or "x" in x`: `"\\\" or \"x\" in x"`,
+	}
+
+	for value, expected := range tests {
+		if quoted := quoteDriveQuery(value); quoted != expected {
+			t.Errorf("expected %s to be quoted as %s, got %s", value, expected, quoted)
+		}
+	}
+}
+
+func TestListFolderEscapesFolderIds(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddDocument(`odd"folder\id`, "pcr", "PCR", "2023-01-01T00:00:00.000Z")
+	source := newTestDriveSource(drive)
+	ctx := context.Background()
+
+	items, err := source.ListFolder(ctx, `odd"folder\id`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].ID != "pcr" {
+		t.Errorf("expected the item in the folder, got %#v", items)
+	}
+
+	// An ID that tries to add another condition to the query is just a folder that does not exist
+	items, err = source.ListFolder(ctx, `x" in parents or "root`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 0 {
+		t.Errorf("expected no items, got %#v", items)
+	}
+}
@@ -0,0 +1,285 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Returned when Drive refuses a request because a usage limit was reached
+var ErrDriveQuotaExceeded = stderrors.New("drive usage limit exceeded")
+
+// Returned when the service account is not allowed to make a request
+var ErrDrivePermissionDenied = stderrors.New("drive permission denied")
+
+// Returned without contacting Drive while the circuit breaker is open, after too many requests in a row have failed
+var ErrDriveUnavailable = stderrors.New("drive is unavailable")
+
+// Reasons Drive gives for a 403 response when a usage limit was reached, rather than when the service account lacks permission
+var quotaReasons = map[string]bool{
+	"rateLimitExceeded":     true,
+	"userRateLimitExceeded": true,
+	"dailyLimitExceeded":    true,
+	"quotaExceeded":         true,
+}
+
+// Reasons that mean the request can succeed if it is tried again later
+var retryableReasons = map[string]bool{
+	"rateLimitExceeded":     true,
+	"userRateLimitExceeded": true,
+	"backendError":          true,
+}
+
+// Limits how long Drive requests can take, how failed requests are retried and when the circuit breaker opens. Zero values use
+// the defaults.
+type DrivePolicy struct {
+	// How long a single attempt can take, including reading the response
+	Timeout time.Duration
+
+	// How many times a failed request is tried again. Set to a negative number to never retry.
+	MaxRetries int
+
+	// How long to wait before the first retry. The wait doubles after each retry, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// How many requests in a row can fail before requests stop being sent to Drive
+	FailureThreshold int
+
+	// How long requests stop being sent to Drive once the circuit breaker opens
+	Cooldown time.Duration
+}
+
+var defaultDrivePolicy = DrivePolicy{
+	Timeout:          time.Minute,
+	MaxRetries:       4,
+	InitialBackoff:   500 * time.Millisecond,
+	MaxBackoff:       16 * time.Second,
+	FailureThreshold: 5,
+	Cooldown:         30 * time.Second,
+}
+
+// Fills in the default for every setting that is not set
+func (p DrivePolicy) withDefaults() DrivePolicy {
+	if p.Timeout <= 0 {
+		p.Timeout = defaultDrivePolicy.Timeout
+	}
+	if p.MaxRetries == 0 {
+		p.MaxRetries = defaultDrivePolicy.MaxRetries
+	} else if p.MaxRetries < 0 {
+		p.MaxRetries = 0
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = defaultDrivePolicy.InitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaultDrivePolicy.MaxBackoff
+	}
+	if p.FailureThreshold <= 0 {
+		p.FailureThreshold = defaultDrivePolicy.FailureThreshold
+	}
+	if p.Cooldown <= 0 {
+		p.Cooldown = defaultDrivePolicy.Cooldown
+	}
+
+	return p
+}
+
+// Gets how long to wait before a retry, with up to half of the wait added at random so clients that failed together do not all
+// retry together
+func (p DrivePolicy) backoff(retry int) time.Duration {
+	wait := p.InitialBackoff << retry
+	if wait > p.MaxBackoff || wait <= 0 {
+		wait = p.MaxBackoff
+	}
+
+	return wait + time.Duration(rand.Int63n(int64(wait)/2+1))
+}
+
+// Stops requests from being sent to Drive after too many requests in a row have failed. Once the cooldown has passed, a single
+// request is let through to check if Drive has recovered.
+type circuitBreaker struct {
+	mutex     sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// Determines if a request can be sent
+func (b *circuitBreaker) allow(policy DrivePolicy, now time.Time) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.failures < policy.FailureThreshold {
+		return true
+	} else if now.Before(b.openUntil) || b.probing {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// Records the outcome of a request, opening the circuit breaker if too many requests in a row have failed
+func (b *circuitBreaker) record(policy DrivePolicy, now time.Time, failed bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= policy.FailureThreshold {
+		b.openUntil = now.Add(policy.Cooldown)
+	}
+}
+
+// Lets another request check if Drive has recovered after a request that did not find out, such as one the caller cancelled.
+// The count of failed requests is left alone.
+func (b *circuitBreaker) release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.probing = false
+}
+
+// Sends a request and returns the response body. Requests that fail because Drive is busy or unavailable are retried with
+// exponential backoff. Requests that create something are only retried when Drive says they were rate limited, since other
+// failures may have happened after the item was created.
+func (d *DriveSource) do(ctx context.Context, method string, requestURL string, contentType string, body []byte) ([]byte, error) {
+	policy := d.Policy.withDefaults()
+	if !d.breaker.allow(policy, time.Now()) {
+		return nil, ErrDriveUnavailable
+	}
+
+	for retry := 0; ; retry++ {
+		resBody, retryAfter, err := d.attempt(ctx, policy, method, requestURL, contentType, body)
+
+		retryable := isRetryable(method, err)
+		if err != nil && ctx.Err() != nil {
+			// The caller gave up, which says nothing about whether Drive is healthy
+			d.breaker.release()
+			return resBody, err
+		}
+
+		if err == nil || !retryable || retry >= policy.MaxRetries {
+			// Only failures that mean Drive is struggling count toward opening the circuit breaker, and only an answer from Drive
+			// closes it
+			if retryable {
+				d.breaker.record(policy, time.Now(), true)
+			} else if driveAnswered(err) {
+				d.breaker.record(policy, time.Now(), false)
+			} else {
+				d.breaker.release()
+			}
+			return resBody, err
+		}
+
+		wait := policy.backoff(retry)
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			d.breaker.release()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Sends a request once. Returns how long Drive asked to wait before trying again, if it did.
+func (d *DriveSource) attempt(ctx context.Context, policy DrivePolicy, method string, requestURL string, contentType string, body []byte) ([]byte, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, policy.Timeout)
+	defer cancel()
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reqBody)
+	if err != nil {
+		return nil, 0, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	res, err := d.Client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer res.Body.Close()
+
+	// Read the response
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, 0, ErrItemNotFound
+	} else if res.StatusCode >= 400 {
+		retryAfter, _ := strconv.Atoi(res.Header.Get("Retry-After"))
+		return nil, time.Duration(retryAfter) * time.Second, &DriveError{StatusCode: res.StatusCode, Body: string(resBody), Reason: driveErrorReason(resBody)}
+	}
+
+	return resBody, 0, nil
+}
+
+// Determines if a request got an answer from Drive, even if the answer was an error
+func driveAnswered(err error) bool {
+	var driveErr *DriveError
+	return err == nil || err == ErrItemNotFound || stderrors.As(err, &driveErr)
+}
+
+// Determines if a failed request can succeed if it is sent again
+func isRetryable(method string, err error) bool {
+	if err == nil || err == ErrItemNotFound {
+		return false
+	}
+
+	driveErr, ok := err.(*DriveError)
+	if !ok {
+		// The request could not be sent or timed out, so the only safe requests to retry are ones that do not create anything
+		return method != http.MethodPost
+	}
+
+	rateLimited := driveErr.StatusCode == http.StatusTooManyRequests || (driveErr.StatusCode == http.StatusForbidden && retryableReasons[driveErr.Reason])
+	if rateLimited {
+		return true
+	}
+
+	return driveErr.StatusCode >= 500 && method != http.MethodPost
+}
+
+// Gets the reason from a Drive error response, such as {"error": {"errors": [{"reason": "rateLimitExceeded"}]}}
+func driveErrorReason(body []byte) string {
+	res := struct {
+		Error struct {
+			Errors []struct {
+				Reason string `json:"reason"`
+			} `json:"errors"`
+		} `json:"error"`
+	}{}
+
+	if err := json.Unmarshal(body, &res); err != nil || len(res.Error.Errors) == 0 {
+		return ""
+	}
+
+	return res.Error.Errors[0].Reason
+}
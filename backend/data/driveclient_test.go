@@ -0,0 +1,300 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// A policy that retries quickly, so tests do not wait on backoff
+var testDrivePolicy = DrivePolicy{
+	Timeout:          time.Second,
+	MaxRetries:       2,
+	InitialBackoff:   time.Millisecond,
+	MaxBackoff:       2 * time.Millisecond,
+	FailureThreshold: 100,
+	Cooldown:         time.Minute,
+}
+
+// Starts a server that answers each request with the next response in the list, repeating the last one. Also returns a function
+// that counts the requests made so far.
+func newScriptedDrive(t *testing.T, responses ...func(w http.ResponseWriter)) (*DriveSource, func() int) {
+	requests := 0
+	mutex := sync.Mutex{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		index := requests
+		if index >= len(responses) {
+			index = len(responses) - 1
+		}
+		requests++
+
+		responses[index](w)
+	}))
+	t.Cleanup(server.Close)
+
+	count := func() int {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return requests
+	}
+
+	return &DriveSource{Client: server.Client(), BaseURL: server.URL, Policy: testDrivePolicy}, count
+}
+
+func respondWith(status int, body string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(status)
+		io.WriteString(w, body)
+	}
+}
+
+func driveErrorBody(code int, reason string) string {
+	return `{"error":{"errors":[{"domain":"usageLimits","reason":"` + reason + `"}],"code":` + strconv.Itoa(code) + `}}`
+}
+
+func TestDriveRetriesUnavailable(t *testing.T) {
+	source, requests := newScriptedDrive(t,
+		respondWith(http.StatusServiceUnavailable, "unavailable"),
+		respondWith(http.StatusInternalServerError, "error"),
+		respondWith(http.StatusOK, `{"id":"doc","title":"Centrifuge"}`),
+	)
+
+	item, err := source.GetItem(context.Background(), "doc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Name != "Centrifuge" {
+		t.Errorf("expected the item after retrying, got %#v", item)
+	}
+	if requests() != 3 {
+		t.Errorf("expected 3 requests, got %d", requests())
+	}
+}
+
+func TestDriveRateLimitExhaustsRetries(t *testing.T) {
+	source, requests := newScriptedDrive(t, respondWith(http.StatusForbidden, driveErrorBody(403, "userRateLimitExceeded")))
+
+	_, err := source.GetItem(context.Background(), "doc")
+	if !stderrors.Is(err, ErrDriveQuotaExceeded) {
+		t.Errorf("expected a quota error, got %v", err)
+	}
+	if requests() != testDrivePolicy.MaxRetries+1 {
+		t.Errorf("expected %d requests, got %d", testDrivePolicy.MaxRetries+1, requests())
+	}
+}
+
+func TestDriveDoesNotRetryClientErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		response func(w http.ResponseWriter)
+		expected error
+	}{
+		{"not found", respondWith(http.StatusNotFound, "not found"), ErrItemNotFound},
+		{"permission denied", respondWith(http.StatusForbidden, driveErrorBody(403, "insufficientFilePermissions")), ErrDrivePermissionDenied},
+		{"daily limit", respondWith(http.StatusForbidden, driveErrorBody(403, "dailyLimitExceeded")), ErrDriveQuotaExceeded},
+	}
+
+	for _, test := range tests {
+		source, requests := newScriptedDrive(t, test.response)
+
+		_, err := source.GetItem(context.Background(), "doc")
+		if !stderrors.Is(err, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, err)
+		}
+		if requests() != 1 {
+			t.Errorf("%s: expected a single request, got %d", test.name, requests())
+		}
+	}
+}
+
+func TestDriveDoesNotRetryFailedCreates(t *testing.T) {
+	source, requests := newScriptedDrive(t, respondWith(http.StatusInternalServerError, "error"))
+
+	if _, err := source.CreateFolder(context.Background(), "root", "Protocols"); err == nil {
+		t.Error("expected an error")
+	}
+	if requests() != 1 {
+		t.Errorf("expected a single request, since the folder may have been created, got %d", requests())
+	}
+}
+
+func TestDriveTimeout(t *testing.T) {
+	source, requests := newScriptedDrive(t, func(w http.ResponseWriter) {
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte(`{"id":"doc"}`))
+	})
+	source.Policy.Timeout = 10 * time.Millisecond
+	source.Policy.MaxRetries = -1
+
+	_, err := source.GetItem(context.Background(), "doc")
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request to time out, got %v", err)
+	}
+	if requests() != 1 {
+		t.Errorf("expected a single request, got %d", requests())
+	}
+}
+
+func TestDriveCircuitBreaker(t *testing.T) {
+	source, requests := newScriptedDrive(t,
+		respondWith(http.StatusInternalServerError, "error"),
+		respondWith(http.StatusInternalServerError, "error"),
+		respondWith(http.StatusOK, `{"id":"doc"}`),
+	)
+	source.Policy.MaxRetries = -1
+	source.Policy.FailureThreshold = 2
+	source.Policy.Cooldown = 50 * time.Millisecond
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := source.GetItem(ctx, "doc"); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+
+	// The circuit breaker is open, so Drive is not contacted
+	if _, err := source.GetItem(ctx, "doc"); err != ErrDriveUnavailable {
+		t.Errorf("expected the circuit breaker to be open, got %v", err)
+	}
+	if requests() != 2 {
+		t.Errorf("expected 2 requests, got %d", requests())
+	}
+
+	// After the cooldown, a request is let through and closes the circuit breaker when it succeeds
+	time.Sleep(60 * time.Millisecond)
+	for i := 0; i < 2; i++ {
+		if _, err := source.GetItem(ctx, "doc"); err != nil {
+			t.Errorf("expected the circuit breaker to be closed, got %v", err)
+		}
+	}
+}
+
+func TestDriveCircuitBreakerIgnoresCancelledRequests(t *testing.T) {
+	source, requests := newScriptedDrive(t, respondWith(http.StatusServiceUnavailable, "unavailable"))
+	source.Policy.MaxRetries = -1
+	source.Policy.FailureThreshold = 3
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A request whose caller gave up while waiting to retry
+	waitForRetry := func() {
+		source.Policy.MaxRetries = 1
+		source.Policy.InitialBackoff = time.Minute
+		source.Policy.MaxBackoff = time.Minute
+		defer func() {
+			source.Policy.MaxRetries = -1
+			source.Policy.InitialBackoff = testDrivePolicy.InitialBackoff
+			source.Policy.MaxBackoff = testDrivePolicy.MaxBackoff
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		if _, err := source.GetItem(ctx, "doc"); !stderrors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected the request to time out while waiting to retry, got %v", err)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := source.GetItem(context.Background(), "doc"); err == nil || err == ErrDriveUnavailable {
+			t.Fatalf("expected Drive to answer with an error, got %v", err)
+		}
+		if i == 2 {
+			break
+		}
+
+		if _, err := source.GetItem(cancelled, "doc"); !stderrors.Is(err, context.Canceled) {
+			t.Errorf("expected the request to be cancelled, got %v", err)
+		}
+		waitForRetry()
+	}
+
+	// Cancelled requests do not reset the count, so the third failure opens the circuit breaker
+	made := requests()
+	if _, err := source.GetItem(context.Background(), "doc"); err != ErrDriveUnavailable {
+		t.Errorf("expected the circuit breaker to be open, got %v", err)
+	}
+	if requests() != made {
+		t.Errorf("expected Drive not to be contacted, got %d more requests", requests()-made)
+	}
+}
+
+// A response body that records if it was closed
+type trackedBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDriveClosesResponseBodies(t *testing.T) {
+	bodies := []*trackedBody{}
+	client := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := &trackedBody{Reader: strings.NewReader("unavailable")}
+		bodies = append(bodies, body)
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}, Body: body}, nil
+	})}
+	source := &DriveSource{Client: client, Policy: testDrivePolicy}
+
+	if _, err := source.GetItem(context.Background(), "doc"); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if len(bodies) != testDrivePolicy.MaxRetries+1 {
+		t.Errorf("expected %d requests, got %d", testDrivePolicy.MaxRetries+1, len(bodies))
+	}
+	for i, body := range bodies {
+		if !body.closed {
+			t.Errorf("expected the body of response %d to be closed", i)
+		}
+	}
+}
+
+func TestSourceErrorsReachClients(t *testing.T) {
+	tests := []struct {
+		name     string
+		response func(w http.ResponseWriter)
+		status   int
+	}{
+		{"not found", respondWith(http.StatusNotFound, "not found"), 404},
+		{"permission denied", respondWith(http.StatusForbidden, driveErrorBody(403, "insufficientFilePermissions")), 403},
+		{"rate limited", respondWith(http.StatusTooManyRequests, "slow down"), 503},
+		{"server error", respondWith(http.StatusInternalServerError, "error"), 500},
+	}
+
+	for _, test := range tests {
+		source, _ := newScriptedDrive(t, test.response)
+		t.Setenv("ROOT_FOLDER_ID", "root")
+		service := &FileService{Source: source}
+
+		_, err := service.GetFolderContents(context.Background(), "protocols", model.FolderSortFieldName, model.SortOrderAsc, nil, nil)
+		if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != test.status {
+			t.Errorf("%s: expected status %d, got %v", test.name, test.status, err)
+		}
+	}
+}
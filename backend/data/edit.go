@@ -0,0 +1,272 @@
+package data
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// Gets the source as an EditSource. Returns an input error if the source's folders cannot be changed.
+func (s *FileService) editSource(ctx context.Context) (EditSource, error) {
+	source, ok := s.Source.(EditSource)
+	if !ok {
+		return nil, errors.NewInputError(ctx, "Folders cannot be changed in this document source.")
+	}
+
+	return source, nil
+}
+
+// Makes sure a folder is the root folder or is inside it. Returns a not found error otherwise.
+func (s *FileService) checkFolderInRoot(ctx context.Context, id string) error {
+	// The root folder is not inside itself, so it is the only folder that is not looked up
+	if id == s.Source.RootFolderID() {
+		return nil
+	}
+
+	folder, err := s.getItemInRoot(ctx, id)
+	if err == ErrItemNotFound || (err == nil && !isFolderType(folder.Type)) {
+		return errors.NewNotFoundError(ctx, "Oops! This folder does not exist.")
+	} else if err != nil {
+		return sourceError(ctx, "An unexpected error occurred while retrieving a folder.", err)
+	}
+
+	return nil
+}
+
+// Gets an item inside the root folder that can be changed. The root folder itself cannot be changed.
+func (s *FileService) getEditableItem(ctx context.Context, id string) (*DriveFolderItem, error) {
+	if id == s.Source.RootFolderID() {
+		return nil, errors.NewInputError(ctx, "The root folder cannot be changed.")
+	}
+
+	item, err := s.getItemInRoot(ctx, id)
+	if err == ErrItemNotFound || (err == nil && !isFolderType(item.Type) && !isFileType(item.Type)) {
+		return nil, errors.NewNotFoundError(ctx, "Oops! This item does not exist.")
+	} else if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving an item.", err)
+	}
+
+	return item, nil
+}
+
+// Checks that a name can be given to a folder or file, and removes the whitespace around it
+func checkItemName(ctx context.Context, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.NewInputError(ctx, "The name cannot be empty.")
+	}
+
+	return name, nil
+}
+
+// Creates a folder inside another folder
+func (s *FileService) CreateFolder(ctx context.Context, parentId string, name string) (*model.Folder, error) {
+	source, err := s.editSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err = checkItemName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkFolderInRoot(ctx, parentId); err != nil {
+		return nil, err
+	}
+
+	item, err := source.CreateFolder(ctx, parentId, name)
+	if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while creating the folder.", err)
+	}
+
+	folder, ok := s.newFolderItem(item).(*model.Folder)
+	if !ok {
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while creating the folder.", ErrUnsupportedFormat)
+	}
+
+	s.folderTree.addItem(parentId, folder)
+
+	return folder, nil
+}
+
+// Renames a folder or file. The new name of a file is saved to the search cache right away.
+func (s *FileService) RenameItem(ctx context.Context, id string, name string) (model.FolderItem, error) {
+	item, err := s.renameItem(ctx, id, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The item was renamed, so a failure here is left for the next sync to fix instead of being returned
+	if file, ok := item.(*model.File); ok {
+		if err := s.renameFileCache(ctx, file.ID, file.Name); err != nil {
+			log.Printf("Could not rename %s in the file cache: %v", file.ID, err)
+		}
+	}
+
+	return item, nil
+}
+
+// Renames an item in the source and in the cached folder tree
+func (s *FileService) renameItem(ctx context.Context, id string, name string) (model.FolderItem, error) {
+	source, err := s.editSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err = checkItemName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getEditableItem(ctx, id); err != nil {
+		return nil, err
+	}
+
+	renamed, err := source.RenameItem(ctx, id, name)
+	if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while renaming the item.", err)
+	}
+
+	item := s.newFolderItem(renamed)
+	if item == nil {
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while renaming the item.", ErrUnsupportedFormat)
+	}
+
+	s.folderTree.replaceItem(item)
+
+	return item, nil
+}
+
+// Moves a folder or file into another folder inside the root folder. A folder cannot be moved into itself or into a folder inside
+// it, and a file cannot be moved directly into the root folder, since only files inside folders are cached. The search cache does
+// not store where files are, so only the cached folder tree changes.
+func (s *FileService) MoveItem(ctx context.Context, id string, folderId string) (model.FolderItem, error) {
+	source, err := s.editSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.getEditableItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkFolderInRoot(ctx, folderId); err != nil {
+		return nil, err
+	}
+
+	if !isFolderType(item.Type) && folderId == s.Source.RootFolderID() {
+		return nil, errors.NewInputError(ctx, "Files must be moved into a folder, not the root folder.")
+	}
+
+	if isFolderType(item.Type) {
+		inside, err := s.isInsideFolder(ctx, folderId, id)
+		if err != nil {
+			return nil, sourceError(ctx, "An unexpected error occurred while moving the item.", err)
+		} else if inside {
+			return nil, errors.NewInputError(ctx, "A folder cannot be moved into itself.")
+		}
+	}
+
+	moved, err := source.MoveItem(ctx, id, folderId)
+	if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while moving the item.", err)
+	}
+
+	movedItem := s.newFolderItem(moved)
+	if movedItem == nil {
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while moving the item.", ErrUnsupportedFormat)
+	}
+
+	s.folderTree.moveItem(folderId, movedItem)
+
+	return movedItem, nil
+}
+
+// Determines if a folder is the same as another folder, or is inside it
+func (s *FileService) isInsideFolder(ctx context.Context, id string, folderId string) (bool, error) {
+	if id == folderId {
+		return true, nil
+	} else if id == s.Source.RootFolderID() {
+		return false, nil
+	}
+
+	checker := newRootChecker(s.Source)
+	item, err := checker.getItem(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	ancestors, err := checker.ancestors(ctx, item)
+	if err != nil {
+		return false, err
+	}
+
+	for _, ancestor := range ancestors {
+		if ancestor.ID == folderId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Moves a folder or file to the trash. Every file in the trash is removed from the search cache right away and added to the
+// prune history.
+func (s *FileService) TrashItem(ctx context.Context, id string) (bool, error) {
+	fileIds, err := s.trashItem(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	// The item is already in the trash, so a failure here is left for the next sync to fix instead of being returned
+	for _, fileId := range fileIds {
+		if _, err := s.archiveFileCache(ctx, fileId, pruneReasonTrashed); err != nil {
+			log.Printf("Could not prune %s from the file cache: %v", fileId, err)
+		}
+	}
+
+	return true, nil
+}
+
+// Moves an item to the trash in the source and removes it from the cached folder tree. Returns the IDs of the files that were
+// moved to the trash, including every file inside a folder.
+func (s *FileService) trashItem(ctx context.Context, id string) ([]string, error) {
+	source, err := s.editSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.getEditableItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the files before they are in the trash, since folders in the trash cannot be listed
+	fileIds := []string{}
+	if isFolderType(item.Type) {
+		files, folderErrors, err := s.traverseFolders(ctx, []string{id})
+		if err != nil {
+			return nil, sourceError(ctx, "An unexpected error occurred while moving the item to the trash.", err)
+		}
+		s.reportFolderErrors(ctx, folderErrors)
+
+		for _, file := range files {
+			fileIds = append(fileIds, file.ID)
+		}
+	} else {
+		fileIds = append(fileIds, id)
+	}
+
+	if err := source.TrashItem(ctx, id); err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while moving the item to the trash.", err)
+	}
+
+	s.folderTree.removeItem(id)
+
+	return fileIds, nil
+}
@@ -0,0 +1,201 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/drivetest"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Creates a fake Drive with a few nested folders, along with a FileService that has already cached the folder tree
+func newEditTestDrive(t *testing.T) (*drivetest.Server, *FileService) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "equipment", "Equipment")
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddFolder("protocols", "buffers", "Buffers")
+	drive.AddDocument("buffers", "pbs", "PBS", "2023-01-01T00:00:00.000Z")
+	drive.AddDocument("protocols", "pcr", "PCR", "2023-01-01T00:00:00.000Z")
+	drive.AddDocument("root", "safety", "Safety", "2023-01-01T00:00:00.000Z")
+	service := newTestFileService(drive)
+
+	if _, err := service.GetFolderTree(context.Background(), "", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	return drive, service
+}
+
+// Formats the cached folder tree as one "parent/id@depth" entry per item
+func formatTree(t *testing.T, service *FileService) string {
+	nodes, err := service.GetFolderTree(context.Background(), "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := []string{}
+	for _, node := range nodes {
+		entries = append(entries, fmt.Sprintf("%s/%s@%d", node.ParentID, node.ID, node.Depth))
+	}
+
+	return strings.Join(entries, " ")
+}
+
+func expectStatus(t *testing.T, err error, status int) {
+	t.Helper()
+
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != status {
+		t.Errorf("expected status %d, got %v", status, err)
+	}
+}
+
+func TestCreateFolder(t *testing.T) {
+	drive, service := newEditTestDrive(t)
+	ctx := context.Background()
+
+	folder, err := service.CreateFolder(ctx, "protocols", "  Antibodies ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if folder.Name != "Antibodies" {
+		t.Errorf("expected the name to be trimmed, got %q", folder.Name)
+	}
+
+	requests := drive.Requests()
+	expected := "root/equipment@1 root/protocols@1 protocols/created-1@2 protocols/buffers@2 buffers/pbs@3 protocols/pcr@2"
+	if tree := formatTree(t, service); tree != expected {
+		t.Errorf("expected %s, got %s", expected, tree)
+	}
+	if drive.Requests() != requests {
+		t.Errorf("expected the cached tree to be updated instead of rebuilt")
+	}
+
+	_, err = service.CreateFolder(ctx, "protocols", " ")
+	expectStatus(t, err, 400)
+
+	_, err = service.CreateFolder(ctx, "missing", "Antibodies")
+	expectStatus(t, err, 404)
+
+	_, err = service.CreateFolder(ctx, "safety", "Antibodies")
+	expectStatus(t, err, 404)
+}
+
+func TestRenameItem(t *testing.T) {
+	drive, service := newEditTestDrive(t)
+	ctx := context.Background()
+
+	item, err := service.renameItem(ctx, "protocols", "Methods")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if folder, ok := item.(*model.Folder); !ok || folder.Name != "Methods" {
+		t.Errorf("expected the renamed folder, got %#v", item)
+	}
+
+	item, err = service.renameItem(ctx, "pcr", "Amplification")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file, ok := item.(*model.File); !ok || file.Name != "Amplification" {
+		t.Errorf("expected the renamed file, got %#v", item)
+	}
+
+	// Renamed items are sorted by their new names
+	requests := drive.Requests()
+	expected := "root/equipment@1 root/protocols@1 protocols/pcr@2 protocols/buffers@2 buffers/pbs@3"
+	if tree := formatTree(t, service); tree != expected {
+		t.Errorf("expected %s, got %s", expected, tree)
+	}
+	if drive.Requests() != requests {
+		t.Errorf("expected the cached tree to be updated instead of rebuilt")
+	}
+
+	_, err = service.renameItem(ctx, "root", "Everything")
+	expectStatus(t, err, 400)
+
+	_, err = service.renameItem(ctx, "missing", "Anything")
+	expectStatus(t, err, 404)
+}
+
+func TestMoveItem(t *testing.T) {
+	drive, service := newEditTestDrive(t)
+	ctx := context.Background()
+
+	if _, err := service.MoveItem(ctx, "buffers", "equipment"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.MoveItem(ctx, "safety", "protocols"); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := drive.Requests()
+	expected := "root/equipment@1 equipment/buffers@2 buffers/pbs@3 root/protocols@1 protocols/pcr@2 protocols/safety@2"
+	if tree := formatTree(t, service); tree != expected {
+		t.Errorf("expected %s, got %s", expected, tree)
+	}
+	if drive.Requests() != requests {
+		t.Errorf("expected the cached tree to be updated instead of rebuilt")
+	}
+
+	// Files are only cached inside folders, so they cannot be moved directly into the root folder
+	_, err := service.MoveItem(ctx, "pcr", "root")
+	expectStatus(t, err, 400)
+	if item := drive.File("pcr"); item == nil || item.Parents[0].ID != "protocols" {
+		t.Errorf("expected PCR to stay in Protocols, got %#v", item)
+	}
+	if tree := formatTree(t, service); tree != expected {
+		t.Errorf("expected %s, got %s", expected, tree)
+	}
+
+	_, err = service.MoveItem(ctx, "equipment", "buffers")
+	expectStatus(t, err, 400)
+
+	_, err = service.MoveItem(ctx, "equipment", "equipment")
+	expectStatus(t, err, 400)
+
+	_, err = service.MoveItem(ctx, "pbs", "missing")
+	expectStatus(t, err, 404)
+}
+
+func TestTrashItem(t *testing.T) {
+	drive, service := newEditTestDrive(t)
+	ctx := context.Background()
+
+	fileIds, err := service.trashItem(ctx, "protocols")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(fileIds)
+	if strings.Join(fileIds, ",") != "pbs,pcr" {
+		t.Errorf("expected every file in the folder to be pruned, got %v", fileIds)
+	}
+	if item := drive.File("protocols"); item != nil {
+		t.Errorf("expected the folder to be in the trash, got %#v", item)
+	}
+
+	if tree := formatTree(t, service); tree != "root/equipment@1" {
+		t.Errorf("expected only Equipment to be left, got %s", tree)
+	}
+
+	fileIds, err = service.trashItem(ctx, "safety")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fileIds) != 1 || fileIds[0] != "safety" {
+		t.Errorf("expected the file to be pruned, got %v", fileIds)
+	}
+
+	_, err = service.trashItem(ctx, "root")
+	expectStatus(t, err, 400)
+}
+
+func TestEditRequiresEditSource(t *testing.T) {
+	service := &FileService{Source: &LocalSource{Root: t.TempDir()}}
+
+	_, err := service.CreateFolder(context.Background(), service.Source.RootFolderID(), "Protocols")
+	expectStatus(t, err, 400)
+}
@@ -2,48 +2,31 @@ package data
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
-	"io"
-	"log"
-	"net/http"
-	"os"
 	"sort"
-	"strings"
+	"strconv"
 	"time"
 
 	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/db"
 	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
 	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
 	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/models"
-	strip "github.com/grokify/html-strip-tags-go"
 )
 
 type FileService struct {
 	Services models.Services
-}
+	Source   DocumentSource
 
-type DriveFolderQueryResponse struct {
-	Items []*DriveFolderItem `json:"items"`
-}
+	// The maximum number of folders listed at the same time when walking the folder tree
+	TraversalWorkers int
 
-type DriveFolderItem struct {
-	ID             string `json:"id"`
-	Name           string `json:"title"`
-	Type           string `json:"mimeType"`
-	Created        string `json:"createdDate"`
-	LastModified   string `json:"modifiedDate"`
-	LastModifiedBy string `json:"lastModifyingUserName"`
-}
+	// How long old snapshots of each file's text are kept
+	SnapshotRetention SnapshotRetention
 
-type DriveSearchQueryResponse struct {
-	Incomplete bool               `json:"incompleteSearch"`
-	Files      []*DriveSearchItem `json:"files"`
-}
-
-type DriveSearchItem struct {
-	Id   string `json:"id"`
-	Name string `json:"name"`
+	syncState  syncState
+	folderTree folderTreeCache
 }
 
 // Creates a new folder struct
@@ -54,7 +37,7 @@ func (s *FileService) NewFolderModel() *model.Folder {
 
 // Creates a new file struct
 func (s *FileService) NewFileModel() *model.File {
-	file := &model.File{}
+	file := &model.File{Owners: []*model.FileOwner{}}
 	return file
 }
 
@@ -65,30 +48,16 @@ func (s *FileService) NewSearchResultModel() *model.SearchResult {
 
 // Gets a list of all folders in the root folder
 func (s *FileService) GetAllFolders(ctx context.Context) ([]*model.Folder, error) {
-	// Make a request to Google Drive API to get all items in the root folder
-	requestURL := fmt.Sprintf("https://www.googleapis.com/drive/v2/files?q=\"%s\"+in+parents&key=%s", os.Getenv("ROOT_FOLDER_ID"), os.Getenv("GOOGLE_DRIVE_API_KEY"))
-	res, err := http.Get(requestURL)
+	// Get all items in the root folder
+	items, err := s.Source.ListFolder(ctx, s.Source.RootFolderID())
 	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving folders.", err)
-	}
-
-	// Read the response
-	resBody, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving folders.", err)
-	}
-
-	// Parse the JSON string response into a struct
-	data := &DriveFolderQueryResponse{}
-	err = json.Unmarshal([]byte(resBody), &data)
-	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving folders.", err)
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving folders.", err)
 	}
 
 	// Filter out only the folder objects, and map those to the model.Folder type
 	folders := []*model.Folder{}
-	for _, item := range data.Items {
-		if item.Type == "application/vnd.google-apps.folder" {
+	for _, item := range items {
+		if isFolderType(item.Type) {
 			folder := s.NewFolderModel()
 
 			folder.ID = item.ID
@@ -106,44 +75,132 @@ func (s *FileService) GetAllFolders(ctx context.Context) ([]*model.Folder, error
 	return folders, nil
 }
 
-// Gets a list of all contents in a folder
-func (s *FileService) GetFolderContents(ctx context.Context, id string) ([]model.FolderItem, error) {
-	// Make a request to Google Drive API to get all items in the root folder
-	requestURL := fmt.Sprintf("https://www.googleapis.com/drive/v2/files?q=\"%s\"+in+parents&key=%s", id, os.Getenv("GOOGLE_DRIVE_API_KEY"))
-	res, err := http.Get(requestURL)
+// Gets a list of the contents of a folder, sorted by the given field. When types is not empty, only items of those types are
+// returned, and when modifiedAfter is not nil, only items that were last modified after that timestamp are returned.
+func (s *FileService) GetFolderContents(ctx context.Context, id string, sortBy model.FolderSortField, order model.SortOrder, types []model.FolderItemType, modifiedAfter *string) ([]model.FolderItem, error) {
+	var after time.Time
+	if modifiedAfter != nil {
+		var err error
+		after, err = time.Parse(time.RFC3339, *modifiedAfter)
+		if err != nil {
+			return nil, errors.NewInputError(ctx, "modifiedAfter must be a timestamp such as 2023-01-01T00:00:00Z.")
+		}
+	}
+
+	// Get all items in the folder, with shortcuts replaced by the items they point to
+	items, err := s.Source.ListFolder(ctx, id)
 	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a folder's contents.", err)
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving a folder's contents.", err)
 	}
 
-	// Read the response
-	resBody, err := io.ReadAll(res.Body)
+	items, err = s.resolveShortcuts(ctx, items)
 	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a folder's contents.", err)
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving a folder's contents.", err)
+	}
+
+	// Leave out the items that do not match the filters
+	matching := []*DriveFolderItem{}
+	for _, item := range items {
+		if len(types) > 0 && !hasFolderItemType(types, item.Type) {
+			continue
+		}
+		if modifiedAfter != nil && !parseSourceTimestamp(item.LastModified).After(after) {
+			continue
+		}
+
+		matching = append(matching, item)
 	}
 
-	// Parse the JSON string response into a struct
-	data := &DriveFolderQueryResponse{}
-	err = json.Unmarshal([]byte(resBody), &data)
+	sortDriveItems(matching, sortBy, order)
+
+	return s.mapFolderItems(matching), nil
+}
+
+// Sorts items by the given field. Items are always sorted by name first, so items with the same timestamp stay in order of name.
+func sortDriveItems(items []*DriveFolderItem, sortBy model.FolderSortField, order model.SortOrder) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	var timestamp func(item *DriveFolderItem) string
+	switch sortBy {
+	case model.FolderSortFieldUpdated:
+		timestamp = func(item *DriveFolderItem) string { return item.LastModified }
+	case model.FolderSortFieldCreated:
+		timestamp = func(item *DriveFolderItem) string { return item.Created }
+	default:
+		if order == model.SortOrderDesc {
+			sort.SliceStable(items, func(i, j int) bool {
+				return items[i].Name > items[j].Name
+			})
+		}
+		return
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		first, second := parseSourceTimestamp(timestamp(items[i])), parseSourceTimestamp(timestamp(items[j]))
+		if order == model.SortOrderDesc {
+			return first.After(second)
+		}
+
+		return first.Before(second)
+	})
+}
+
+// Checks if an item with the given mime type is one of the given types
+func hasFolderItemType(types []model.FolderItemType, mimeType string) bool {
+	var itemType model.FolderItemType
+	if isFolderType(mimeType) {
+		itemType = model.FolderItemTypeFolder
+	} else if isFileType(mimeType) {
+		// Each kind of file has an item type with the same name
+		itemType = model.FolderItemType(newFileKind(mimeType))
+	} else {
+		return false
+	}
+
+	for _, t := range types {
+		if t == itemType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Parses a timestamp from the document source. Returns the zero time if the timestamp is missing or invalid.
+func parseSourceTimestamp(timestamp string) time.Time {
+	t, err := time.Parse(time.RFC3339, timestamp)
 	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a folder's contents.", err)
+		return time.Time{}
 	}
 
-	// Sort data items by name
-	sort.SliceStable(data.Items, func(i, j int) bool {
-		return data.Items[i].Name < data.Items[j].Name
+	return t
+}
+
+// Sorts the items in a folder by name and maps them to model.Folder and model.File. Unsupported items are left out.
+func (s *FileService) newFolderItems(items []*DriveFolderItem) []model.FolderItem {
+	// Sort items by name
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
 	})
 
-	// Map items to model.Folder and model.File
+	return s.mapFolderItems(items)
+}
+
+// Maps items to model.Folder and model.File, keeping their order. Unsupported items are left out.
+func (s *FileService) mapFolderItems(items []*DriveFolderItem) []model.FolderItem {
 	contents := []model.FolderItem{}
-	for _, item := range data.Items {
-		if item.Type == "application/vnd.google-apps.folder" {
+	for _, item := range items {
+		if isFolderType(item.Type) {
 			folder := s.NewFolderModel()
 
 			folder.ID = item.ID
 			folder.Name = item.Name
+			folder.IsShortcut = item.IsShortcut
 
 			contents = append(contents, folder)
-		} else if strings.Contains(item.Type, "document") {
+		} else if isFileType(item.Type) {
 			file := s.NewFileModel()
 
 			file.ID = item.ID
@@ -151,38 +208,84 @@ func (s *FileService) GetFolderContents(ctx context.Context, id string) ([]model
 			file.Created = item.Created
 			file.LastUpdated = item.LastModified
 			file.LastModifiedBy = item.LastModifiedBy
+			file.MimeType = item.Type
+			file.Kind = newFileKind(item.Type)
+			setFileMetadata(file, item)
+			file.IsShortcut = item.IsShortcut
 
 			contents = append(contents, file)
 		}
 	}
 
-	return contents, nil
+	return contents
 }
 
-// Gets a single folder by ID
-func (s *FileService) GetFolderById(ctx context.Context, id string) (*model.Folder, error) {
-	// Make a request to Google Drive API to get all items in the root folder
-	requestURL := fmt.Sprintf("https://www.googleapis.com/drive/v2/files/%s?key=%s", id, os.Getenv("GOOGLE_DRIVE_API_KEY"))
-	res, err := http.Get(requestURL)
-	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a folder.", err)
+// Copies the Drive metadata of an item that is only used for display, such as its owners and links, into a file
+func setFileMetadata(file *model.File, item *DriveFolderItem) {
+	if size, err := strconv.ParseInt(item.Size, 10, 64); err == nil {
+		file.SizeBytes = &size
 	}
 
-	// Read the response
-	resBody, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a folder.", err)
+	file.Owners = []*model.FileOwner{}
+	for _, owner := range item.Owners {
+		fileOwner := &model.FileOwner{Name: owner.DisplayName}
+		if owner.EmailAddress != "" {
+			email := owner.EmailAddress
+			fileOwner.Email = &email
+		}
+		file.Owners = append(file.Owners, fileOwner)
 	}
 
-	// Parse the JSON string response into a struct
-	data := &DriveFolderItem{}
-	err = json.Unmarshal([]byte(resBody), &data)
-	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a folder.", err)
+	file.WebViewLink = optionalString(item.WebViewLink)
+	file.Description = optionalString(item.Description)
+	file.IconLink = optionalString(item.IconLink)
+	file.Starred = item.Labels.Starred
+}
+
+// Gets a pointer to a string, or nil if the string is empty
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+// Maps a single item to a model.Folder or model.File. Returns nil if the item is not supported.
+func (s *FileService) newFolderItem(item *DriveFolderItem) model.FolderItem {
+	items := s.newFolderItems([]*DriveFolderItem{item})
+	if len(items) == 0 {
+		return nil
+	}
+
+	return items[0]
+}
+
+// Gets the kind of a file from its mime type. Files cached before mime types were saved are assumed to be documents.
+func newFileKind(mimeType string) model.FileKind {
+	switch mimeType {
+	case pdfMimeType:
+		return model.FileKindPDF
+	case spreadsheetMimeType:
+		return model.FileKindSpreadsheet
+	case presentationMimeType:
+		return model.FileKindPresentation
+	}
+
+	return model.FileKindDocument
+}
+
+// Gets a single folder by ID
+func (s *FileService) GetFolderById(ctx context.Context, id string) (*model.Folder, error) {
+	data, err := s.getItemInRoot(ctx, id)
+	if err == ErrItemNotFound {
+		return nil, errors.NewNotFoundError(ctx, "Oops! This folder does not exist.")
+	} else if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving a folder.", err)
 	}
 
 	// Make sure the requested resource is actually a folder
-	if data.Type != "application/vnd.google-apps.folder" {
+	if !isFolderType(data.Type) {
 		return nil, errors.NewNotFoundError(ctx, "Oops! This folder does not exist.")
 	}
 
@@ -196,28 +299,15 @@ func (s *FileService) GetFolderById(ctx context.Context, id string) (*model.Fold
 
 // Gets a single file by ID
 func (s *FileService) GetFileById(ctx context.Context, id string) (*model.File, error) {
-	// Make a request to Google Drive API to get all items in the root folder
-	requestURL := fmt.Sprintf("https://www.googleapis.com/drive/v2/files/%s?key=%s", id, os.Getenv("GOOGLE_DRIVE_API_KEY"))
-	res, err := http.Get(requestURL)
-	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a file.", err)
-	}
-
-	// Read the response
-	resBody, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a file.", err)
-	}
-
-	// Parse the JSON string response into a struct
-	data := &DriveFolderItem{}
-	err = json.Unmarshal([]byte(resBody), &data)
-	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a file.", err)
+	data, err := s.getItemInRoot(ctx, id)
+	if err == ErrItemNotFound {
+		return nil, errors.NewNotFoundError(ctx, "Oops! This file does not exist.")
+	} else if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving a file.", err)
 	}
 
 	// Make sure the requested resource is actually a file
-	if !strings.Contains(data.Type, "document") && !strings.Contains(data.Type, "pdf") {
+	if !isFileType(data.Type) {
 		return nil, errors.NewNotFoundError(ctx, "Oops! This file does not exist.")
 	}
 
@@ -228,190 +318,193 @@ func (s *FileService) GetFileById(ctx context.Context, id string) (*model.File,
 	file.Created = data.Created
 	file.LastUpdated = data.LastModified
 	file.LastModifiedBy = data.LastModifiedBy
+	file.MimeType = data.Type
+	file.Kind = newFileKind(data.Type)
+	setFileMetadata(file, data)
 
 	return file, nil
 }
 
-func (s *FileService) ListFilesByDate(ctx context.Context) ([]*model.File, error) {
-	files, err := s.getAllFiles(ctx)
+// Gets a page of the files in the cache, from most recently modified to least recent
+func (s *FileService) ListFilesByDate(ctx context.Context, first int, after *string) (*model.FileConnection, error) {
+	cursor, err := parsePageRequest(ctx, first, after)
 	if err != nil {
 		return nil, err
 	}
 
-	// Sort files by last updated timestamp
-	sort.SliceStable(files, func(i, j int) bool {
-		t1, err := time.Parse(time.RFC3339, files[i].LastUpdated)
-		if err != nil {
-			log.Printf("Error parsing time: %s for file: %s", err, files[i].Name)
-			return false
-		}
-		t2, err := time.Parse(time.RFC3339, files[j].LastUpdated)
-		if err != nil {
-			log.Printf("Error parsing time: %s for file: %s", err, files[j].Name)
-			return true
+	// Files without a timestamp are listed last, and files with the same timestamp are listed by ID
+	query := "SELECT " + fileCacheColumns + " FROM file"
+	args := []interface{}{}
+	if cursor != nil {
+		lastUpdated := parseCacheTimestamp(cursor.Key)
+		if cursor.Key != "" && !lastUpdated.Valid {
+			return nil, errors.NewInputError(ctx, "The after cursor is not valid.")
 		}
-		return t1.After(t2)
-	})
 
-	return files, nil
-}
-
-func (s *FileService) SearchFiles(ctx context.Context, query string) ([]*model.File, error) {
-	files, err := s.getAllFiles(ctx)
-	if err != nil {
-		return nil, err
+		query += " WHERE (COALESCE(last_updated, '-infinity'), id) < (COALESCE($1, '-infinity'::timestamptz), $2)"
+		args = append(args, lastUpdated, cursor.ID)
 	}
+	query += fmt.Sprintf(" ORDER BY COALESCE(last_updated, '-infinity') DESC, id DESC LIMIT $%d;", len(args)+1)
+	args = append(args, first+1)
 
-	cachedFiles, err := s.getCachedFiles(ctx)
+	files, err := s.queryFileCache(ctx, "An unexpected error occurred while retrieving files.", query, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Make sure every file in the drive root folder has an updated cache
-	for _, file := range files {
-		fileLastUpdatedUTC, err := time.Parse("2006-01-02T15:04:05.000Z", file.LastUpdated)
-		if err != nil {
-			return nil, err
-		}
-
-		if cachedFiles[file.ID] == nil {
-			contents, err := s.getFileContents(ctx, file.ID)
-			if err != nil {
-				return nil, err
-			}
-
-			strippedContent := strip.StripTags(*contents)
-			strippedContent = strings.ReplaceAll(strippedContent, "&nbsp;", "")
-
-			s.saveFileCache(ctx, file.ID, file.Name, &strippedContent)
-		} else {
-			cacheLastUpdatedUTC, err := time.Parse(time.RFC3339Nano, cachedFiles[file.ID].LastUpdated)
-			if err != nil {
-				return nil, err
-			}
-
-			if fileLastUpdatedUTC.After(cacheLastUpdatedUTC) {
-				contents, err := s.getFileContents(ctx, file.ID)
-				if err != nil {
-					return nil, err
-				}
-
-				strippedContent := strip.StripTags(*contents)
-				strippedContent = strings.ReplaceAll(strippedContent, "&nbsp;", "")
-
-				s.saveFileCache(ctx, file.ID, file.Name, &strippedContent)
-			}
-		}
-	}
-
-	// Search the cache for the query
-	foundFileIDs, err := s.searchFileCache(ctx, query)
-	if err != nil {
-		return nil, err
-	}
-
-	// Map the found file IDs to the actual file objects
-	foundFiles := []*model.File{}
-	for _, foundFileID := range foundFileIDs {
-		for _, file := range files {
-			if file.ID == *foundFileID {
-				foundFiles = append(foundFiles, file)
-			}
-		}
+	var totalCount int
+	if err := db.DB.QueryRow("SELECT COUNT(*) FROM file;").Scan(&totalCount); err != nil {
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving files.", err)
 	}
 
-	return foundFiles, nil
+	return newFileConnection(files, first, cursor, totalCount, func(file *model.File) string {
+		return file.LastUpdated
+	}), nil
 }
 
-// Gets all files in a Drive folder, including files in nested folders
-func (s *FileService) getAllFiles(ctx context.Context) ([]*model.File, error) {
-	rootFolders, err := s.GetAllFolders(ctx)
+// Gets a page of the files in the cache that have a matching title or contents, sorted by title. The cache is kept up to date
+// by the background sync.
+func (s *FileService) SearchFiles(ctx context.Context, query string, first int, after *string) (*model.FileConnection, error) {
+	cursor, err := parsePageRequest(ctx, first, after)
 	if err != nil {
 		return nil, err
 	}
 
-	files := []*model.File{}
-
-	for _, folder := range rootFolders {
-		nestedFiles, err := s.getFilesInFolderRec(ctx, folder.ID)
-		if err != nil {
-			return nil, err
-		}
+	pattern := "%" + query + "%"
 
-		files = append(files, nestedFiles...)
+	// Files with the same title are sorted by ID
+	sqlQuery := "SELECT " + fileCacheColumns + " FROM file WHERE (title ILIKE $1 OR contents ILIKE $1)"
+	args := []interface{}{pattern}
+	if cursor != nil {
+		sqlQuery += " AND (title, id) > ($2, $3)"
+		args = append(args, cursor.Key, cursor.ID)
 	}
+	sqlQuery += fmt.Sprintf(" ORDER BY title, id LIMIT $%d;", len(args)+1)
+	args = append(args, first+1)
 
-	return files, nil
-}
-
-// Gets all files in a Drive folder, including files in nested folders. You should call getAllFiles instead of this function.
-func (s *FileService) getFilesInFolderRec(ctx context.Context, folderId string) ([]*model.File, error) {
-	files := []*model.File{}
-
-	// Get all files in this folder
-	folderItems, err := s.GetFolderContents(ctx, folderId)
+	files, err := s.queryFileCache(ctx, "An unexpected error occurred while searching for files.", sqlQuery, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Append all files in this folder to the slice. If the item is a folder, recursively call this function
-	for _, item := range folderItems {
-		if file, ok := item.(*model.File); ok {
-			files = append(files, file)
-		} else if folder, ok := item.(*model.Folder); ok {
-			nestedFiles, err := s.getFilesInFolderRec(ctx, folder.ID)
-			if err != nil {
-				return nil, err
-			}
-
-			files = append(files, nestedFiles...)
-		}
+	var totalCount int
+	if err := db.DB.QueryRow("SELECT COUNT(*) FROM file WHERE title ILIKE $1 OR contents ILIKE $1;", pattern).Scan(&totalCount); err != nil {
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while searching for files.", err)
 	}
 
-	return files, nil
+	return newFileConnection(files, first, cursor, totalCount, func(file *model.File) string {
+		return file.Name
+	}), nil
 }
 
 // Gets all files that are cached in the database
 func (s *FileService) getCachedFiles(ctx context.Context) (map[string]*model.File, error) {
-	rows, err := db.DB.Query("SELECT id, title, snapshot_timestamp FROM file;")
+	cachedFiles, err := s.queryFileCache(ctx, "An unexpected error occurred while retrieving cached files.", "SELECT "+fileCacheColumns+" FROM file;")
 	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving cached files.", err)
+		return nil, err
 	}
 
 	files := map[string]*model.File{}
+	for _, file := range cachedFiles {
+		files[file.ID] = file
+	}
 
-	for rows.Next() {
-		file := &model.File{}
-		if err := rows.Scan(&file.ID, &file.Name, &file.LastUpdated); err != nil {
-			return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving cached files.", err)
+	return files, nil
+}
+
+// Gets the text of a file that is saved in the cache, or nil if the file is not cached
+func (s *FileService) getCachedFileText(ctx context.Context, id string) (*string, error) {
+	var contents string
+	row := db.DB.QueryRow("SELECT contents FROM file WHERE id = $1;", id)
+	if err := row.Scan(&contents); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
 		}
 
-		files[file.ID] = file
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a cached file.", err)
 	}
 
-	return files, nil
+	return &contents, nil
 }
 
-// Saves the text content of a file to the database
-func (s *FileService) saveFileCache(ctx context.Context, id string, title string, contents *string) error {
+// Saves the text content and metadata of a file to the database. A new snapshot of the text is kept whenever it has changed since
+// the last snapshot, so earlier versions are not lost when the cache is refreshed.
+func (s *FileService) saveFileCache(ctx context.Context, file *model.File, contents *string) error {
 	if contents == nil {
 		return errors.NewInputError(ctx, "File contents cannot be nil.")
 	}
 
+	now := time.Now().UTC()
+	contentHash := hashContents(*contents)
+
+	owners, err := json.Marshal(file.Owners)
+	if err != nil {
+		return errors.NewInternalError(ctx, "An unexpected error occurred while saving a file.", err)
+	}
+
 	tx, err := db.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return errors.NewInternalError(ctx, "An unexpected error occurred while saving a file.", err)
 	}
 
+	// Hold a lock on the file until the transaction ends, so saves that race, such as an upload during a sync, do not read the same
+	// latest snapshot and try to insert the same version
+	_, err = tx.Exec("SELECT pg_advisory_xact_lock(hashtext($1));", file.ID)
+	if err != nil {
+		tx.Rollback()
+		return errors.NewInternalError(ctx, "An unexpected error occurred while saving a file.", err)
+	}
+
+	// Find the most recent snapshot, if there is one
+	var latestVersion int
+	var latestHash string
+	row := tx.QueryRow("SELECT version, content_hash FROM file_snapshot WHERE file_id = $1 ORDER BY version DESC LIMIT 1;", file.ID)
+	if err := row.Scan(&latestVersion, &latestHash); err != nil && err != sql.ErrNoRows {
+		tx.Rollback()
+		return errors.NewInternalError(ctx, "An unexpected error occurred while saving a file.", err)
+	}
+
+	if needsSnapshot(latestVersion, latestHash, contentHash) {
+		_, err = tx.Exec("INSERT INTO file_snapshot (file_id, version, content_hash, contents, captured_at, last_updated, last_modified_by) VALUES ($1, $2, $3, $4, $5, $6, $7);",
+			file.ID,
+			latestVersion+1,
+			contentHash,
+			*contents,
+			now,
+			parseCacheTimestamp(file.LastUpdated),
+			file.LastModifiedBy,
+		)
+		if err != nil {
+			tx.Rollback()
+			return errors.NewInternalError(ctx, "An unexpected error occurred while saving a file.", err)
+		}
+	}
+
 	// Delete the existing file cache, if there is one
-	_, err = tx.Exec("DELETE FROM file WHERE id = $1;", id)
+	_, err = tx.Exec("DELETE FROM file WHERE id = $1;", file.ID)
 	if err != nil {
 		tx.Rollback()
 		return errors.NewInternalError(ctx, "An unexpected error occurred while saving a file.", err)
 	}
 
 	// Insert the new file cache
-	_, err = tx.Exec("INSERT INTO file (id, title, contents, snapshot_timestamp) VALUES ($1, $2, $3, $4);", id, title, *contents, time.Now().UTC())
+	_, err = tx.Exec("INSERT INTO file (id, title, contents, snapshot_timestamp, created, last_updated, last_modified_by, mime_type, content_hash, size_bytes, owners, web_view_link, description, icon_link, starred) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);",
+		file.ID,
+		file.Name,
+		*contents,
+		now,
+		parseCacheTimestamp(file.Created),
+		parseCacheTimestamp(file.LastUpdated),
+		file.LastModifiedBy,
+		file.MimeType,
+		contentHash,
+		file.SizeBytes,
+		string(owners),
+		file.WebViewLink,
+		file.Description,
+		file.IconLink,
+		file.Starred,
+	)
 	if err != nil {
 		tx.Rollback()
 		return errors.NewInternalError(ctx, "An unexpected error occurred while saving a file.", err)
@@ -426,44 +519,177 @@ func (s *FileService) saveFileCache(ctx context.Context, id string, title string
 	return nil
 }
 
-// Gets the text content of a file
-func (s *FileService) getFileContents(ctx context.Context, id string) (*string, error) {
-	// Make a request to Google Drive API to get all items in the root folder
-	requestURL := fmt.Sprintf("https://docs.google.com/document/d/%s/export", id)
-	res, err := http.Get(requestURL)
+// Changes the title of a cached file
+func (s *FileService) renameFileCache(ctx context.Context, id string, title string) error {
+	_, err := db.DB.Exec("UPDATE file SET title = $2 WHERE id = $1;", id, title)
+	if err != nil {
+		return errors.NewInternalError(ctx, "An unexpected error occurred while renaming a file in the cache.", err)
+	}
+
+	return nil
+}
+
+// Removes a file from the cache and adds it to the prune history, in a single statement. Returns the title of the removed file,
+// or nil if the file was not cached.
+func (s *FileService) archiveFileCache(ctx context.Context, id string, reason string) (*string, error) {
+	row := db.DB.QueryRow("WITH removed AS (DELETE FROM file WHERE id = $1 RETURNING id, title) INSERT INTO file_prune_log (file_id, title, reason, pruned_at) SELECT id, title, $2, $3 FROM removed RETURNING title;", id, reason, time.Now().UTC())
+
+	var title string
+	if err := row.Scan(&title); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while removing a file from the cache.", err)
+	}
+
+	return &title, nil
+}
+
+// Gets the most recently pruned files
+func (s *FileService) GetPruneHistory(ctx context.Context, limit int) ([]*model.PrunedFile, error) {
+	rows, err := db.DB.Query("SELECT file_id, title, reason, pruned_at FROM file_prune_log ORDER BY pruned_at DESC, id DESC LIMIT $1;", limit)
 	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a file.", err)
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving the prune history.", err)
+	}
+	defer rows.Close()
+
+	prunedFiles := []*model.PrunedFile{}
+
+	for rows.Next() {
+		prunedFile := &model.PrunedFile{}
+		var prunedAt time.Time
+		if err := rows.Scan(&prunedFile.ID, &prunedFile.Name, &prunedFile.Reason, &prunedAt); err != nil {
+			return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving the prune history.", err)
+		}
+		prunedFile.PrunedAt = prunedAt.UTC().Format(time.RFC3339)
+
+		prunedFiles = append(prunedFiles, prunedFile)
+	}
+
+	return prunedFiles, nil
+}
+
+// Gets a value saved by a previous sync, or an empty string if there is none
+func (s *FileService) getSyncValue(ctx context.Context, key string) (string, error) {
+	var value string
+	row := db.DB.QueryRow("SELECT value FROM sync_state WHERE key = $1;", key)
+	if err := row.Scan(&value); err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+
+		return "", errors.NewInternalError(ctx, "An unexpected error occurred while retrieving the sync state.", err)
+	}
+
+	return value, nil
+}
+
+// Saves a value for the next sync
+func (s *FileService) setSyncValue(ctx context.Context, key string, value string) error {
+	_, err := db.DB.Exec("INSERT INTO sync_state (key, value) VALUES ($1, $2) ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value;", key, value)
+	if err != nil {
+		return errors.NewInternalError(ctx, "An unexpected error occurred while saving the sync state.", err)
+	}
+
+	return nil
+}
+
+// Converts a timestamp from the document source to a value that can be stored in a TIMESTAMPTZ column
+func parseCacheTimestamp(timestamp string) sql.NullTime {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
+
+// Converts a TIMESTAMPTZ column to the timestamp format used by the document source
+func formatCacheTimestamp(timestamp sql.NullTime) string {
+	if !timestamp.Valid {
+		return ""
 	}
 
-	// Read the response
-	resBody, err := io.ReadAll(res.Body)
+	return timestamp.Time.UTC().Format(driveTimestampFormat)
+}
+
+// Gets the text content of a file
+func (s *FileService) getFileContents(ctx context.Context, file *model.File) (*string, error) {
+	resBody, err := s.exportFileContent(ctx, file, htmlMimeType)
 	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a file.", err)
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving a file.", err)
 	}
 
-	// Parse the JSON string response into a struct
 	contents := string(resBody)
 
 	return &contents, nil
 }
 
-// Searches all files in the cache for files that have a matching title or contents. Returns an array with the IDs of every file that matches the query.
-func (s *FileService) searchFileCache(ctx context.Context, query string) ([]*string, error) {
-	rows, err := db.DB.Query("SELECT id FROM file WHERE title ILIKE $1 OR contents ILIKE $1;", "%"+query+"%")
+// Gets the content of a file as sanitized HTML, optionally from a revision. Files that cannot be exported as HTML, such as PDFs, are shown as the text that is searched.
+func (s *FileService) GetFileContent(ctx context.Context, id string, revisionId *string) (string, error) {
+	file, err := s.getFileAtRevision(ctx, id, revisionId)
 	if err != nil {
-		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while searching for files.", err)
+		return "", err
 	}
 
-	fileIDs := []*string{}
+	document, err := s.exportFileContent(ctx, file, htmlMimeType)
+	if err == ErrUnsupportedFormat {
+		text, err := s.getFileText(ctx, file)
+		if err != nil {
+			return "", sourceError(ctx, "An unexpected error occurred while retrieving a file.", err)
+		}
+
+		document = textToHTML(*text)
+	} else if err != nil {
+		return "", sourceError(ctx, "An unexpected error occurred while retrieving a file.", err)
+	}
+
+	content, err := sanitizeHTML(document)
+	if err != nil {
+		return "", errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a file.", err)
+	}
+
+	return content, nil
+}
+
+// The columns of the file table that queryFileCache reads, in order
+const fileCacheColumns = "id, title, created, last_updated, last_modified_by, mime_type, size_bytes, owners, web_view_link, description, icon_link, starred"
+
+// Gets the cached files returned by a query that selects fileCacheColumns. The error message is returned to clients if the query fails.
+func (s *FileService) queryFileCache(ctx context.Context, errorMessage string, query string, args ...interface{}) ([]*model.File, error) {
+	rows, err := db.DB.Query(query, args...)
+	if err != nil {
+		return nil, errors.NewInternalError(ctx, errorMessage, err)
+	}
+	defer rows.Close()
+
+	files := []*model.File{}
 
 	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
-			return nil, errors.NewInternalError(ctx, "An unexpected error occurred while searching for files.", err)
+		file := s.NewFileModel()
+		var created, lastUpdated sql.NullTime
+		var sizeBytes sql.NullInt64
+		var owners string
+		var webViewLink, description, iconLink sql.NullString
+		if err := rows.Scan(&file.ID, &file.Name, &created, &lastUpdated, &file.LastModifiedBy, &file.MimeType, &sizeBytes, &owners, &webViewLink, &description, &iconLink, &file.Starred); err != nil {
+			return nil, errors.NewInternalError(ctx, errorMessage, err)
 		}
+		if err := json.Unmarshal([]byte(owners), &file.Owners); err != nil {
+			return nil, errors.NewInternalError(ctx, errorMessage, err)
+		}
+		file.Created = formatCacheTimestamp(created)
+		file.LastUpdated = formatCacheTimestamp(lastUpdated)
+		file.Kind = newFileKind(file.MimeType)
+		if sizeBytes.Valid {
+			file.SizeBytes = &sizeBytes.Int64
+		}
+		file.WebViewLink = optionalString(webViewLink.String)
+		file.Description = optionalString(description.String)
+		file.IconLink = optionalString(iconLink.String)
 
-		fileIDs = append(fileIDs, &id)
+		files = append(files, file)
 	}
 
-	return fileIDs, nil
+	return files, nil
 }
@@ -0,0 +1,286 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/drivetest"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// Starts a fake Drive that returns folder children a few items at a time, with "root" as the root folder
+func newFakeDrive(t *testing.T, pageSize int) *drivetest.Server {
+	drive := drivetest.NewServer(t)
+	drive.PageSize = pageSize
+
+	t.Setenv("ROOT_FOLDER_ID", "root")
+
+	return drive
+}
+
+// Creates a DriveSource that sends every request to a fake Drive
+func newTestDriveSource(drive *drivetest.Server) *DriveSource {
+	return &DriveSource{Client: drive.Client(), BaseURL: drive.URL, UploadURL: drive.UploadURL}
+}
+
+func newTestFileService(drive *drivetest.Server) *FileService {
+	return &FileService{Source: newTestDriveSource(drive)}
+}
+
+func TestGetAllFoldersFollowsPages(t *testing.T) {
+	drive := newFakeDrive(t, 2)
+	for i := 0; i < 5; i++ {
+		drive.AddFolder("root", fmt.Sprintf("folder-%d", i), fmt.Sprintf("Folder %d", i))
+	}
+	drive.AddDocument("root", "doc", "Loose document", "2023-01-01T00:00:00.000Z")
+
+	folders, err := newTestFileService(drive).GetAllFolders(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(folders) != 5 {
+		t.Fatalf("expected 5 folders, got %d", len(folders))
+	}
+	for i, folder := range folders {
+		if folder.ID != fmt.Sprintf("folder-%d", i) {
+			t.Errorf("expected folder-%d at position %d, got %s", i, i, folder.ID)
+		}
+	}
+	if drive.Requests() != 3 {
+		t.Errorf("expected 3 page requests, got %d", drive.Requests())
+	}
+}
+
+func TestGetFolderContentsFollowsPages(t *testing.T) {
+	drive := newFakeDrive(t, 3)
+	drive.AddFolder("folder", "nested", "B Nested")
+	for i := 0; i < 7; i++ {
+		drive.AddDocument("folder", fmt.Sprintf("doc-%d", i), fmt.Sprintf("A Document %d", i), "2023-01-01T00:00:00.000Z")
+	}
+
+	contents, err := newTestFileService(drive).GetFolderContents(context.Background(), "folder", model.FolderSortFieldName, model.SortOrderAsc, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(contents) != 8 {
+		t.Fatalf("expected 8 items, got %d", len(contents))
+	}
+	if _, ok := contents[7].(*model.Folder); !ok {
+		t.Errorf("expected the nested folder to be sorted last, got %#v", contents[7])
+	}
+}
+
+func TestGetFolderContentsIncludesSheetsAndSlides(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddDocument("folder", "doc", "A Document", "2023-01-01T00:00:00.000Z")
+	drive.AddFile("folder", &drivetest.File{ID: "sheet", Title: "B Reagents", MimeType: spreadsheetMimeType})
+	drive.AddFile("folder", &drivetest.File{ID: "slides", Title: "C Training", MimeType: presentationMimeType})
+	drive.AddFile("folder", &drivetest.File{ID: "form", Title: "D Form", MimeType: "application/vnd.google-apps.form"})
+
+	contents, err := newTestFileService(drive).GetFolderContents(context.Background(), "folder", model.FolderSortFieldName, model.SortOrderAsc, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(contents) != 3 {
+		t.Fatalf("expected 3 files, got %d", len(contents))
+	}
+	expected := []model.FileKind{model.FileKindDocument, model.FileKindSpreadsheet, model.FileKindPresentation}
+	for i, kind := range expected {
+		if file, ok := contents[i].(*model.File); !ok || file.Kind != kind {
+			t.Errorf("expected a %s at position %d, got %#v", kind, i, contents[i])
+		}
+	}
+}
+
+func TestFilesIncludeDriveMetadata(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFile("root", &drivetest.File{
+		ID:            "manual",
+		Title:         "Autoclave manual",
+		MimeType:      drivetest.PDFMimeType,
+		FileSize:      "5368709120",
+		Owners:        []*drivetest.User{{DisplayName: "Lab Manager", EmailAddress: "manager@example.edu"}, {DisplayName: "Hidden"}},
+		AlternateLink: "https://drive.google.com/file/d/manual/view",
+		Description:   "From the manufacturer",
+		IconLink:      "https://drive-thirdparty.googleusercontent.com/16/type/application/pdf",
+		Labels:        drivetest.Labels{Starred: true},
+	})
+	drive.AddDocument("root", "doc", "Safety", "2023-01-01T00:00:00.000Z")
+	service := newTestFileService(drive)
+
+	file, err := service.GetFileById(context.Background(), "manual")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.SizeBytes == nil || *file.SizeBytes != 5368709120 {
+		t.Errorf("expected the size of the file, even when it is too large for 32 bits, got %v", file.SizeBytes)
+	}
+	if len(file.Owners) != 2 || file.Owners[0].Name != "Lab Manager" || file.Owners[0].Email == nil || *file.Owners[0].Email != "manager@example.edu" || file.Owners[1].Email != nil {
+		t.Errorf("expected both owners, with an email address only for the first, got %#v", file.Owners)
+	}
+	if file.WebViewLink == nil || *file.WebViewLink != "https://drive.google.com/file/d/manual/view" {
+		t.Errorf("expected the Drive link of the file, got %v", file.WebViewLink)
+	}
+	if file.Description == nil || *file.Description != "From the manufacturer" || file.IconLink == nil || !file.Starred {
+		t.Errorf("expected the description, icon and star of the file, got %#v", file)
+	}
+
+	contents, err := service.GetFolderContents(context.Background(), "root", model.FolderSortFieldName, model.SortOrderAsc, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, ok := contents[1].(*model.File)
+	if !ok || doc.ID != "doc" {
+		t.Fatalf("expected the document second, got %#v", contents[1])
+	}
+	if doc.SizeBytes != nil || doc.Owners == nil || len(doc.Owners) != 0 || doc.WebViewLink != nil || doc.Starred {
+		t.Errorf("expected a Google Doc without a size or other metadata, got %#v", doc)
+	}
+}
+
+func TestGetFolderContentsSortsAndFilters(t *testing.T) {
+	drive := newFakeDrive(t, 2)
+	drive.AddFile("folder", &drivetest.File{ID: "nested", Title: "Archive", MimeType: drivetest.FolderMimeType, CreatedDate: "2022-06-01T00:00:00.000Z", ModifiedDate: "2023-03-01T00:00:00.000Z"})
+	drive.AddFile("folder", &drivetest.File{ID: "pcr", Title: "PCR", MimeType: drivetest.DocumentMimeType, CreatedDate: "2022-01-01T00:00:00.000Z", ModifiedDate: "2023-02-01T00:00:00.000Z"})
+	drive.AddFile("folder", &drivetest.File{ID: "manual", Title: "Manual", MimeType: drivetest.PDFMimeType, CreatedDate: "2022-03-01T00:00:00.000Z", ModifiedDate: "2023-01-01T00:00:00.000Z"})
+	drive.AddFile("folder", &drivetest.File{ID: "buffers", Title: "Buffers", MimeType: drivetest.DocumentMimeType, CreatedDate: "2022-02-01T00:00:00.000Z", ModifiedDate: "2023-02-01T00:00:00.000Z"})
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	ids := func(contents []model.FolderItem) string {
+		result := ""
+		for _, item := range contents {
+			if file, ok := item.(*model.File); ok {
+				result += file.ID + " "
+			} else {
+				result += item.(*model.Folder).ID + " "
+			}
+		}
+		return result
+	}
+
+	tests := []struct {
+		name          string
+		sortBy        model.FolderSortField
+		order         model.SortOrder
+		types         []model.FolderItemType
+		modifiedAfter *string
+		expected      string
+	}{
+		{"name", model.FolderSortFieldName, model.SortOrderAsc, nil, nil, "nested buffers manual pcr "},
+		{"name descending", model.FolderSortFieldName, model.SortOrderDesc, nil, nil, "pcr manual buffers nested "},
+		{"recently updated first", model.FolderSortFieldUpdated, model.SortOrderDesc, nil, nil, "nested buffers pcr manual "},
+		{"oldest first", model.FolderSortFieldCreated, model.SortOrderAsc, nil, nil, "pcr buffers manual nested "},
+		{"only documents and PDFs", model.FolderSortFieldName, model.SortOrderAsc, []model.FolderItemType{model.FolderItemTypeDocument, model.FolderItemTypePDF}, nil, "buffers manual pcr "},
+		{"only folders", model.FolderSortFieldName, model.SortOrderAsc, []model.FolderItemType{model.FolderItemTypeFolder}, nil, "nested "},
+		{"modified after", model.FolderSortFieldUpdated, model.SortOrderAsc, nil, optionalString("2023-01-15T00:00:00Z"), "buffers pcr nested "},
+	}
+	for _, test := range tests {
+		contents, err := service.GetFolderContents(ctx, "folder", test.sortBy, test.order, test.types, test.modifiedAfter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids(contents) != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, ids(contents))
+		}
+	}
+
+	_, err := service.GetFolderContents(ctx, "folder", model.FolderSortFieldName, model.SortOrderAsc, nil, optionalString("last week"))
+	expectStatus(t, err, 400)
+}
+
+// Saves files to the search cache, with each file's name as its text
+func cacheTestFiles(t *testing.T, service *FileService, files ...*model.File) {
+	for _, file := range files {
+		contents := file.Name
+		file.Owners = []*model.FileOwner{}
+		if err := service.saveFileCache(context.Background(), file, &contents); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// Follows the after cursor of each page until the last page, returning the ID of every file in the order they were listed
+func collectPages(t *testing.T, expectedTotal int, fetch func(after *string) (*model.FileConnection, error)) []string {
+	ids := []string{}
+	var after *string
+
+	for page := 0; ; page++ {
+		if page > expectedTotal {
+			t.Fatalf("expected at most %d pages, got %v so far", expectedTotal, ids)
+		}
+
+		connection, err := fetch(after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if connection.TotalCount != expectedTotal {
+			t.Errorf("expected a total count of %d, got %d", expectedTotal, connection.TotalCount)
+		}
+
+		for _, edge := range connection.Edges {
+			ids = append(ids, edge.Node.ID)
+		}
+		if !connection.PageInfo.HasNextPage {
+			return ids
+		}
+		after = connection.PageInfo.EndCursor
+	}
+}
+
+func TestListFilesByDateIncludesEveryPage(t *testing.T) {
+	newTestDB(t)
+	service := &FileService{}
+	ctx := context.Background()
+
+	// Files with the same timestamp or no timestamp are split across pages
+	cacheTestFiles(t, service,
+		&model.File{ID: "a", Name: "Autoclave", LastUpdated: "2023-03-01T00:00:00.250Z"},
+		&model.File{ID: "b", Name: "Buffers", LastUpdated: "2023-02-01T00:00:00.000Z"},
+		&model.File{ID: "c", Name: "Centrifuge", LastUpdated: "2023-02-01T00:00:00.000Z"},
+		&model.File{ID: "d", Name: "Disposal", LastUpdated: "2023-02-01T00:00:00.000Z"},
+		&model.File{ID: "e", Name: "Eyewash"},
+		&model.File{ID: "f", Name: "Fume hood"},
+		&model.File{ID: "g", Name: "Gloves", LastUpdated: "2023-01-01T00:00:00.000Z"},
+		&model.File{ID: "h", Name: "Hazards"},
+	)
+
+	ids := collectPages(t, 8, func(after *string) (*model.FileConnection, error) {
+		return service.ListFilesByDate(ctx, 2, after)
+	})
+
+	// Newest first, then by ID from last to first, with files without a timestamp at the end
+	expected := []string{"a", "d", "c", "b", "g", "h", "f", "e"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+}
+
+func TestSearchFilesIncludesEveryPage(t *testing.T) {
+	newTestDB(t)
+	service := &FileService{}
+	ctx := context.Background()
+
+	// Files with the same title are split across pages
+	cacheTestFiles(t, service,
+		&model.File{ID: "pcr-3", Name: "PCR"},
+		&model.File{ID: "pcr-1", Name: "PCR"},
+		&model.File{ID: "pcr-2", Name: "PCR"},
+		&model.File{ID: "qpcr", Name: "qPCR"},
+		&model.File{ID: "gel", Name: "Gel after PCR"},
+		&model.File{ID: "safety", Name: "Safety"},
+	)
+
+	ids := collectPages(t, 5, func(after *string) (*model.FileConnection, error) {
+		return service.SearchFiles(ctx, "pcr", 2, after)
+	})
+
+	expected := []string{"gel", "pcr-1", "pcr-2", "pcr-3", "qpcr"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+}
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/drivetest"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// Starts a fake Drive seeded with the drivetest fixture tree, along with a FileService that reads from it
+func newFixtureFileService(t *testing.T) (*drivetest.Server, *FileService) {
+	drive := newFakeDrive(t, 2)
+	if err := drive.LoadFixture(drivetest.Fixture, "root"); err != nil {
+		t.Fatal(err)
+	}
+
+	return drive, newTestFileService(drive)
+}
+
+func TestFixtureFolders(t *testing.T) {
+	_, service := newFixtureFileService(t)
+	ctx := context.Background()
+
+	folders, err := service.GetAllFolders(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folders) != 2 || folders[0].Name != "Equipment" || folders[1].Name != "Protocols" {
+		t.Errorf("expected Equipment and Protocols, got %#v", folders)
+	}
+
+	contents, err := service.GetFolderContents(ctx, "protocols", model.FolderSortFieldName, model.SortOrderAsc, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contents) != 2 {
+		t.Fatalf("expected 2 items in Protocols, got %d", len(contents))
+	}
+	if folder, ok := contents[0].(*model.Folder); !ok || folder.ID != "protocols-buffers" {
+		t.Errorf("expected the Buffers folder first, got %#v", contents[0])
+	}
+	if file, ok := contents[1].(*model.File); !ok || file.Name != "PCR" || file.Kind != model.FileKindDocument {
+		t.Errorf("expected the PCR document second, got %#v", contents[1])
+	}
+
+	nodes, err := service.GetFolderTree(ctx, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := []string{}
+	for _, node := range nodes {
+		ids = append(ids, node.ID)
+	}
+	expected := "equipment equipment-autoclave equipment-centrifuge protocols protocols-buffers protocols-buffers-pbs protocols-pcr"
+	if strings.Join(ids, " ") != expected {
+		t.Errorf("expected the tree %s, got %s", expected, strings.Join(ids, " "))
+	}
+}
+
+func TestFixtureFiles(t *testing.T) {
+	_, service := newFixtureFileService(t)
+	ctx := context.Background()
+
+	file, err := service.GetFileById(ctx, "protocols-buffers-pbs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Name != "PBS" || file.LastUpdated != drivetest.FixtureTimestamp || file.LastModifiedBy != "Lab Manager" {
+		t.Errorf("unexpected file: %#v", file)
+	}
+
+	path, err := service.GetPath(ctx, file.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(path) != 2 || path[0].Name != "Protocols" || path[1].Name != "Buffers" {
+		t.Errorf("expected the path Protocols > Buffers, got %#v", path)
+	}
+
+	content, err := service.GetFileContent(ctx, "equipment-centrifuge", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content, "<li>Spin at 4000 rpm for 10 minutes.</li>") || strings.Contains(content, "<meta") {
+		t.Errorf("expected the sanitized document, got %s", content)
+	}
+
+	text, err := service.getFileText(ctx, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(*text, "Adjust the pH to 7.4 & fill to 1 L.") {
+		t.Errorf("expected the text of PBS, got %s", *text)
+	}
+}
+
+func TestFixtureDownloads(t *testing.T) {
+	_, service := newFixtureFileService(t)
+	ctx := context.Background()
+
+	download, err := service.DownloadFile(ctx, "equipment-autoclave", nil, model.DownloadFormatTxt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if download.FileName != "Autoclave.txt" || !strings.Contains(string(download.Content), "Sterilize at 121 °C for 20 minutes.") {
+		t.Errorf("expected the text export, got %s: %s", download.FileName, download.Content)
+	}
+
+	// Drive cannot export Markdown from the fixture, so it is converted from the HTML export
+	download, err = service.DownloadFile(ctx, "equipment-autoclave", nil, model.DownloadFormatMd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(download.Content), "# Autoclave") {
+		t.Errorf("expected Markdown converted from HTML, got %s", download.Content)
+	}
+}
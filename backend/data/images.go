@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/auth"
+)
+
+// The largest image that is proxied, in bytes
+const maxProxiedImageSize = 10 << 20
+
+// Google serves the images in exported documents from subdomains of this host, such as lh3.googleusercontent.com
+var defaultImageHosts = []string{"googleusercontent.com"}
+
+// Returned when an image URL is not on one of the proxy's allowed hosts
+var ErrImageHostNotAllowed = stderrors.New("image host not allowed")
+
+// Serves the images embedded in SOPs at /images/proxy?src=, so they are loaded through this server instead of from Google.
+// Only images on the allowed hosts are proxied, so the proxy cannot be used to load anything else. Must be used behind
+// auth.Middleware, since only logged in users can read SOPs.
+type ImageProxy struct {
+	// The client used to load images. When this is nil, a client with a 30 second timeout that only follows redirects to the allowed
+	// hosts is used.
+	Client *http.Client
+
+	// The hosts that images can be loaded from, including their subdomains. Defaults to Google's image hosts.
+	AllowedHosts []string
+}
+
+// An image loaded by the proxy
+type proxiedImage struct {
+	contentType string
+	content     []byte
+}
+
+func (p *ImageProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if auth.GetUserFromContext(r.Context()) == nil {
+		http.Error(w, "You must be logged in to view images.", http.StatusUnauthorized)
+		return
+	}
+
+	// Redirects to hosts that are not allowed come back wrapped in a *url.Error
+	image, err := p.fetch(r.Context(), r.URL.Query().Get("src"))
+	if stderrors.Is(err, ErrImageHostNotAllowed) {
+		http.Error(w, "This image cannot be loaded.", http.StatusBadRequest)
+		return
+	} else if err != nil {
+		http.Error(w, "The image could not be loaded.", http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", image.contentType)
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Write(image.content)
+}
+
+// Loads an image, making sure it is on an allowed host and really is an image
+func (p *ImageProxy) fetch(ctx context.Context, src string) (*proxiedImage, error) {
+	if !p.isAllowed(src) {
+		return nil, ErrImageHostNotAllowed
+	}
+
+	client := p.Client
+	if client == nil {
+		client = &http.Client{
+			Timeout: 30 * time.Second,
+			// Redirects have to stay on the allowed hosts too
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= 10 || !p.isAllowed(req.URL.String()) {
+					return ErrImageHostNotAllowed
+				}
+				return nil
+			},
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("image responded with status %d", res.StatusCode)
+	}
+
+	// SVG images are not proxied, since they can contain scripts
+	contentType := res.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "image/") || strings.Contains(contentType, "svg") {
+		return nil, fmt.Errorf("unexpected content type %q", contentType)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(res.Body, maxProxiedImageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxProxiedImageSize {
+		return nil, fmt.Errorf("image is larger than %d bytes", maxProxiedImageSize)
+	}
+
+	return &proxiedImage{contentType: contentType, content: content}, nil
+}
+
+// Determines if an image URL is on one of the allowed hosts
+func (p *ImageProxy) isAllowed(src string) bool {
+	link, err := url.Parse(src)
+	if err != nil || (link.Scheme != "http" && link.Scheme != "https") {
+		return false
+	}
+
+	hosts := p.AllowedHosts
+	if len(hosts) == 0 {
+		hosts = defaultImageHosts
+	}
+
+	host := strings.ToLower(link.Hostname())
+	for _, allowed := range hosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+
+	return false
+}
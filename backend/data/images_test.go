@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/auth"
+)
+
+func newImageServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rotor.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write([]byte("png"))
+		case "/drawing.svg":
+			w.Header().Set("Content-Type", "image/svg+xml")
+			w.Write([]byte("<svg><script>alert(1)</script></svg>"))
+		case "/moved.png":
+			// Redirect to the same server by a host name that is not allowed
+			http.Redirect(w, r, strings.Replace("http://"+r.Host, "127.0.0.1", "localhost", 1)+"/rotor.png", http.StatusFound)
+		case "/page":
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte("<p>not an image</p>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestImageProxyLoadsImages(t *testing.T) {
+	server := newImageServer(t)
+	proxy := &ImageProxy{Client: server.Client(), AllowedHosts: []string{"127.0.0.1"}}
+
+	image, err := proxy.fetch(context.Background(), server.URL+"/rotor.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image.contentType != "image/png" || string(image.content) != "png" {
+		t.Errorf("unexpected image %#v", image)
+	}
+
+	for _, path := range []string{"/drawing.svg", "/page", "/missing.png"} {
+		if _, err := proxy.fetch(context.Background(), server.URL+path); err == nil {
+			t.Errorf("expected %s to be rejected", path)
+		}
+	}
+}
+
+func TestImageProxyOnlyLoadsAllowedHosts(t *testing.T) {
+	proxy := &ImageProxy{}
+
+	for _, src := range []string{"https://lh3.googleusercontent.com/abc", "https://googleusercontent.com/abc"} {
+		if !proxy.isAllowed(src) {
+			t.Errorf("expected %s to be allowed", src)
+		}
+	}
+	for _, src := range []string{"https://evilgoogleusercontent.com/abc", "http://169.254.169.254/latest", "file:///etc/passwd", "https://googleusercontent.com.example.com/abc"} {
+		if proxy.isAllowed(src) {
+			t.Errorf("expected %s to be rejected", src)
+		}
+	}
+
+	if _, err := proxy.fetch(context.Background(), "http://127.0.0.1/rotor.png"); err != ErrImageHostNotAllowed {
+		t.Errorf("expected ErrImageHostNotAllowed, got %v", err)
+	}
+}
+
+func TestImageProxyRequiresLogin(t *testing.T) {
+	server := newImageServer(t)
+	proxy := &ImageProxy{Client: server.Client(), AllowedHosts: []string{"127.0.0.1"}}
+
+	res := httptest.NewRecorder()
+	proxy.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/images/proxy?src="+url.QueryEscape(server.URL+"/rotor.png"), nil))
+
+	if res.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", res.Code)
+	}
+}
+
+func TestImageProxyRejectsRedirectsToOtherHosts(t *testing.T) {
+	server := newImageServer(t)
+
+	// The default client is used, since it is the one that checks redirects
+	proxy := &ImageProxy{AllowedHosts: []string{"127.0.0.1"}}
+
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/images/proxy?src="+url.QueryEscape(server.URL+"/moved.png"), nil)
+	proxy.ServeHTTP(res, req.WithContext(auth.WithUser(req.Context(), &auth.AuthUser{ID: "1"})))
+
+	if res.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", res.Code)
+	}
+}
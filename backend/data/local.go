@@ -0,0 +1,380 @@
+package data
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"html"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The namespace of the elements in a DOCX document body
+const wordprocessingNamespace = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+
+// The timestamp format used by Google Drive, which the rest of the FileService expects
+const driveTimestampFormat = "2006-01-02T15:04:05.000Z"
+
+// A DocumentSource that serves a directory tree of HTML, Markdown, DOCX and PDF files from local disk.
+// Each directory is a folder, and item IDs are the encoded path of the item relative to Root.
+type LocalSource struct {
+	Root string
+}
+
+func (l *LocalSource) RootFolderID() string {
+	return l.encodeID(".")
+}
+
+func (l *LocalSource) ListFolder(ctx context.Context, folderId string) ([]*DriveFolderItem, error) {
+	relPath, err := l.decodeID(folderId)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(l.Root, filepath.FromSlash(relPath)))
+	if os.IsNotExist(err) {
+		return nil, ErrItemNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	items := []*DriveFolderItem{}
+	for _, entry := range entries {
+		// Skip hidden files, such as .DS_Store
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		item := l.newItem(path.Join(relPath, entry.Name()), info)
+		if item.Type == "" {
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (l *LocalSource) GetItem(ctx context.Context, id string) (*DriveFolderItem, error) {
+	relPath, err := l.decodeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(filepath.Join(l.Root, filepath.FromSlash(relPath)))
+	if os.IsNotExist(err) {
+		return nil, ErrItemNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	item := l.newItem(relPath, info)
+	if item.Type == "" {
+		return nil, ErrItemNotFound
+	}
+
+	return item, nil
+}
+
+// Exports a file as HTML, or as is when the requested type is the type of the file
+func (l *LocalSource) ExportContent(ctx context.Context, id string, mimeType string) ([]byte, error) {
+	item, err := l.GetItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	relPath, _ := l.decodeID(id)
+	contents, err := os.ReadFile(filepath.Join(l.Root, filepath.FromSlash(relPath)))
+	if err != nil {
+		return nil, err
+	}
+
+	if mimeType == item.Type {
+		return contents, nil
+	} else if mimeType != htmlMimeType {
+		return nil, ErrUnsupportedFormat
+	}
+
+	switch item.Type {
+	case htmlMimeType:
+		return contents, nil
+	case markdownMimeType:
+		return markdownToHTML(contents), nil
+	case docxMimeType:
+		return docxToHTML(contents)
+	}
+
+	return nil, ErrUnsupportedFormat
+}
+
+func (l *LocalSource) DownloadContent(ctx context.Context, id string) ([]byte, error) {
+	if _, err := l.GetItem(ctx, id); err != nil {
+		return nil, err
+	}
+
+	relPath, _ := l.decodeID(id)
+	return os.ReadFile(filepath.Join(l.Root, filepath.FromSlash(relPath)))
+}
+
+// Writes a file into a folder. Files on disk cannot be converted, so they are always stored as is.
+func (l *LocalSource) UploadFile(ctx context.Context, folderId string, name string, mimeType string, content io.Reader, convert bool) (*DriveFolderItem, error) {
+	relPath, err := l.decodeID(folderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only use the last element of the name, so the file cannot be written outside of the folder
+	relPath = path.Join(relPath, path.Base("/"+filepath.ToSlash(name)))
+	filePath := filepath.Join(l.Root, filepath.FromSlash(relPath))
+
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if os.IsExist(err) {
+		return nil, ErrItemExists
+	} else if os.IsNotExist(err) {
+		return nil, ErrItemNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	_, err = io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(filePath)
+		return nil, err
+	}
+
+	return l.GetItem(ctx, l.encodeID(relPath))
+}
+
+// Maps a file on disk to an item, using the same mime types as Google Drive. The type is left empty for unsupported files.
+func (l *LocalSource) newItem(relPath string, info os.FileInfo) *DriveFolderItem {
+	item := &DriveFolderItem{
+		ID:           l.encodeID(relPath),
+		Name:         info.Name(),
+		Created:      info.ModTime().UTC().Format(driveTimestampFormat),
+		LastModified: info.ModTime().UTC().Format(driveTimestampFormat),
+	}
+	if relPath != "." {
+		item.Parents = []*DriveParent{{ID: l.encodeID(path.Dir(relPath))}}
+	}
+
+	if info.IsDir() {
+		item.Type = folderMimeType
+		return item
+	}
+
+	extension := strings.ToLower(filepath.Ext(info.Name()))
+	item.Name = strings.TrimSuffix(info.Name(), filepath.Ext(info.Name()))
+	item.Size = strconv.FormatInt(info.Size(), 10)
+
+	switch extension {
+	case ".html", ".htm":
+		item.Type = htmlMimeType
+	case ".md", ".markdown":
+		item.Type = markdownMimeType
+	case ".docx":
+		item.Type = docxMimeType
+	case ".pdf":
+		item.Type = pdfMimeType
+	}
+
+	return item
+}
+
+func (l *LocalSource) encodeID(relPath string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(relPath))
+}
+
+// Decodes an item ID into a slash separated path relative to Root. The path is cleaned so it can never point outside of Root.
+func (l *LocalSource) decodeID(id string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return "", ErrItemNotFound
+	}
+
+	relPath := strings.TrimPrefix(path.Clean("/"+string(decoded)), "/")
+	if relPath == "" {
+		relPath = "."
+	}
+
+	return relPath, nil
+}
+
+// Converts a Markdown document to HTML. Only headings, lists, code blocks and paragraphs are supported, which is enough for SOPs to be read and searched.
+func markdownToHTML(markdown []byte) []byte {
+	out := &bytes.Buffer{}
+	paragraph := []string{}
+	list := ""
+	inCode := false
+
+	flushParagraph := func() {
+		if len(paragraph) > 0 {
+			out.WriteString("<p>" + html.EscapeString(strings.Join(paragraph, " ")) + "</p>\n")
+			paragraph = []string{}
+		}
+	}
+	closeList := func() {
+		if list != "" {
+			out.WriteString("</" + list + ">\n")
+			list = ""
+		}
+	}
+	openList := func(tag string) {
+		if list != tag {
+			closeList()
+			out.WriteString("<" + tag + ">\n")
+			list = tag
+		}
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(string(markdown), "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			flushParagraph()
+			closeList()
+			if inCode {
+				out.WriteString("</code></pre>\n")
+			} else {
+				out.WriteString("<pre><code>")
+			}
+			inCode = !inCode
+			continue
+		}
+
+		if inCode {
+			out.WriteString(html.EscapeString(line) + "\n")
+			continue
+		}
+
+		switch {
+		case trimmed == "":
+			flushParagraph()
+			closeList()
+		case strings.HasPrefix(trimmed, "#"):
+			flushParagraph()
+			closeList()
+			level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+			if level > 6 {
+				level = 6
+			}
+			tag := "h" + string(rune('0'+level))
+			out.WriteString("<" + tag + ">" + html.EscapeString(strings.TrimSpace(strings.TrimLeft(trimmed, "#"))) + "</" + tag + ">\n")
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") || strings.HasPrefix(trimmed, "+ "):
+			flushParagraph()
+			openList("ul")
+			out.WriteString("<li>" + html.EscapeString(strings.TrimSpace(trimmed[2:])) + "</li>\n")
+		case orderedListItem(trimmed) != "":
+			flushParagraph()
+			openList("ol")
+			out.WriteString("<li>" + html.EscapeString(orderedListItem(trimmed)) + "</li>\n")
+		default:
+			closeList()
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+
+	flushParagraph()
+	closeList()
+	if inCode {
+		out.WriteString("</code></pre>\n")
+	}
+
+	return out.Bytes()
+}
+
+// Gets the text of an ordered list item such as "1. Add buffer", or an empty string if the line is not an ordered list item
+func orderedListItem(line string) string {
+	digits := len(line) - len(strings.TrimLeft(line, "0123456789"))
+	if digits == 0 || !strings.HasPrefix(line[digits:], ". ") {
+		return ""
+	}
+
+	return strings.TrimSpace(line[digits+2:])
+}
+
+// Converts the body of a DOCX document to HTML, keeping only paragraphs, headings and line breaks
+func docxToHTML(docx []byte) ([]byte, error) {
+	archive, err := zip.NewReader(bytes.NewReader(docx), int64(len(docx)))
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := archive.Open("word/document.xml")
+	if err != nil {
+		return nil, err
+	}
+	defer document.Close()
+
+	out := &bytes.Buffer{}
+	paragraph := &strings.Builder{}
+	tag := "p"
+	inText := false
+
+	decoder := xml.NewDecoder(document)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space != wordprocessingNamespace {
+				continue
+			}
+
+			switch t.Name.Local {
+			case "p":
+				paragraph.Reset()
+				tag = "p"
+			case "pStyle":
+				for _, attr := range t.Attr {
+					level := strings.TrimPrefix(attr.Value, "Heading")
+					if attr.Name.Local == "val" && len(level) == 1 && level >= "1" && level <= "6" {
+						tag = "h" + level
+					}
+				}
+			case "t":
+				inText = true
+			case "tab":
+				paragraph.WriteString(" ")
+			case "br":
+				paragraph.WriteString("<br>")
+			}
+		case xml.CharData:
+			if inText {
+				paragraph.WriteString(html.EscapeString(string(t)))
+			}
+		case xml.EndElement:
+			if t.Name.Space != wordprocessingNamespace {
+				continue
+			}
+
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				out.WriteString("<" + tag + ">" + paragraph.String() + "</" + tag + ">\n")
+			}
+		}
+	}
+
+	return out.Bytes(), nil
+}
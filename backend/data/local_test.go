@@ -0,0 +1,136 @@
+package data
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+func writeTestFile(t *testing.T, name string, contents []byte) {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(name, contents, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestDocx(t *testing.T, body string) []byte {
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	document, err := archive.Create("word/document.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	document.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` + body + `</w:body></w:document>`))
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func newTestLocalSource(t *testing.T) *LocalSource {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "Buffers", "PBS.md"), []byte("# PBS\n\n1. Add salt\n2. Stir & wait\n"))
+	writeTestFile(t, filepath.Join(root, "Buffers", "Tris.docx"), newTestDocx(t, `<w:p><w:pPr><w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t>Tris</w:t></w:r></w:p><w:p><w:r><w:t>Dissolve in water</w:t></w:r></w:p>`))
+	writeTestFile(t, filepath.Join(root, "Buffers", "notes.txt"), []byte("ignored"))
+	writeTestFile(t, filepath.Join(root, "Safety.html"), []byte("<p>Wear gloves</p>"))
+	return &LocalSource{Root: root}
+}
+
+func TestLocalSourceListsFolders(t *testing.T) {
+	service := &FileService{Source: newTestLocalSource(t)}
+
+	folders, err := service.GetAllFolders(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folders) != 1 || folders[0].Name != "Buffers" {
+		t.Fatalf("expected only the Buffers folder, got %#v", folders)
+	}
+
+	contents, err := service.GetFolderContents(context.Background(), folders[0].ID, model.FolderSortFieldName, model.SortOrderAsc, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contents) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(contents))
+	}
+	if file, ok := contents[0].(*model.File); !ok || file.Name != "PBS" {
+		t.Errorf("expected PBS to be listed first, got %#v", contents[0])
+	}
+}
+
+func TestLocalSourceExportsContent(t *testing.T) {
+	source := newTestLocalSource(t)
+	ctx := context.Background()
+
+	folders, err := source.ListFolder(ctx, source.RootFolderID())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exported := map[string]string{}
+	for _, folder := range folders {
+		items, _ := source.ListFolder(ctx, folder.ID)
+		for _, item := range append(items, folder) {
+			if isDocumentType(item.Type) {
+				contents, err := source.ExportContent(ctx, item.ID, htmlMimeType)
+				if err != nil {
+					t.Fatal(err)
+				}
+				exported[item.Name] = string(contents)
+			}
+		}
+	}
+
+	expected := map[string]string{
+		"PBS":    "<h1>PBS</h1>\n<ol>\n<li>Add salt</li>\n<li>Stir &amp; wait</li>\n</ol>\n",
+		"Tris":   "<h1>Tris</h1>\n<p>Dissolve in water</p>\n",
+		"Safety": "<p>Wear gloves</p>",
+	}
+	for name, html := range expected {
+		if exported[name] != html {
+			t.Errorf("unexpected HTML for %s: %q", name, exported[name])
+		}
+	}
+}
+
+func TestLocalSourceStaysInsideRoot(t *testing.T) {
+	source := newTestLocalSource(t)
+	outside := source.encodeID("../../etc")
+
+	_, err := source.GetItem(context.Background(), outside)
+	if err != ErrItemNotFound {
+		t.Errorf("expected ErrItemNotFound, got %v", err)
+	}
+
+	file, err := (&FileService{Source: source}).GetFileById(context.Background(), source.encodeID("Buffers/PBS.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(file.LastUpdated, "20") {
+		t.Errorf("expected a Drive style timestamp, got %s", file.LastUpdated)
+	}
+}
+
+func TestLocalSourceFileSize(t *testing.T) {
+	source := newTestLocalSource(t)
+
+	file, err := (&FileService{Source: source}).GetFileById(context.Background(), source.encodeID("Safety.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.SizeBytes == nil || *file.SizeBytes != int64(len("<p>Wear gloves</p>")) {
+		t.Errorf("expected the size of the file on disk, got %v", file.SizeBytes)
+	}
+	if file.WebViewLink != nil || len(file.Owners) != 0 {
+		t.Errorf("expected no Drive metadata for a local file, got %#v", file)
+	}
+}
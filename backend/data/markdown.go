@@ -0,0 +1,242 @@
+package data
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Matches runs of blank lines left between blocks
+var blankLines = regexp.MustCompile(`\n[ \t]*\n(?:[ \t]*\n)+`)
+
+// Escapes characters that would otherwise be read as Markdown formatting
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "#", `\#`, "[", `\[`, "]", `\]`)
+
+// Converts an HTML document, such as a Google Docs export, to Markdown. Headings, paragraphs, lists, links, images, tables, code
+// and bold or italic text are kept, and everything else is reduced to its text.
+func htmlToMarkdown(document []byte) ([]byte, error) {
+	root, err := html.Parse(bytes.NewReader(document))
+	if err != nil {
+		return nil, err
+	}
+
+	markdown := blankLines.ReplaceAllString(markdownChildren(root, 0), "\n\n")
+	return []byte(strings.TrimSpace(markdown) + "\n"), nil
+}
+
+// Converts the children of a node to Markdown. The list depth is the number of lists the node is nested in.
+func markdownChildren(node *html.Node, listDepth int) string {
+	out := &strings.Builder{}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		out.WriteString(markdownNode(child, listDepth))
+	}
+	return out.String()
+}
+
+func markdownNode(node *html.Node, listDepth int) string {
+	switch node.Type {
+	case html.TextNode:
+		return markdownEscaper.Replace(collapseWhitespace(node.Data))
+	case html.DocumentNode:
+		return markdownChildren(node, listDepth)
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	switch node.DataAtom {
+	case atom.Head, atom.Script, atom.Style, atom.Title:
+		return ""
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(node.Data[1] - '0')
+		return "\n\n" + strings.Repeat("#", level) + " " + singleLine(markdownChildren(node, listDepth)) + "\n\n"
+	case atom.P, atom.Div:
+		return "\n\n" + strings.TrimSpace(markdownChildren(node, listDepth)) + "\n\n"
+	case atom.Br:
+		return "  \n"
+	case atom.Hr:
+		return "\n\n---\n\n"
+	case atom.Strong, atom.B:
+		return wrapInline(markdownChildren(node, listDepth), "**")
+	case atom.Em, atom.I:
+		return wrapInline(markdownChildren(node, listDepth), "*")
+	case atom.Span:
+		// Google Docs marks bold and italic text with inline styles instead of tags
+		text := markdownChildren(node, listDepth)
+		style := strings.ReplaceAll(attribute(node, "style"), " ", "")
+		if strings.Contains(style, "font-weight:700") || strings.Contains(style, "font-weight:bold") {
+			text = wrapInline(text, "**")
+		}
+		if strings.Contains(style, "font-style:italic") {
+			text = wrapInline(text, "*")
+		}
+		return text
+	case atom.Code:
+		return "`" + textContent(node) + "`"
+	case atom.Pre:
+		return "\n\n```\n" + strings.Trim(textContent(node), "\n") + "\n```\n\n"
+	case atom.A:
+		text := markdownChildren(node, listDepth)
+		href := attribute(node, "href")
+		if href == "" || strings.TrimSpace(text) == "" {
+			return text
+		}
+		return "[" + strings.TrimSpace(text) + "](" + href + ")"
+	case atom.Img:
+		return "![" + markdownEscaper.Replace(attribute(node, "alt")) + "](" + attribute(node, "src") + ")"
+	case atom.Ul, atom.Ol:
+		return markdownList(node, listDepth)
+	case atom.Blockquote:
+		lines := strings.Split(strings.TrimSpace(blankLines.ReplaceAllString(markdownChildren(node, listDepth), "\n\n")), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		return "\n\n" + strings.Join(lines, "\n") + "\n\n"
+	case atom.Table:
+		return markdownTable(node)
+	}
+
+	return markdownChildren(node, listDepth)
+}
+
+// Converts a list to Markdown, indenting nested lists under their parent item
+func markdownList(node *html.Node, listDepth int) string {
+	out := &strings.Builder{}
+	indent := strings.Repeat("   ", listDepth)
+	number := 1
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.DataAtom != atom.Li {
+			continue
+		}
+
+		marker := "- "
+		if node.DataAtom == atom.Ol {
+			marker = fmt.Sprintf("%d. ", number)
+			number++
+		}
+
+		// Paragraphs inside list items are joined into one item, but nested lists stay on their own lines
+		item := strings.TrimSpace(blankLines.ReplaceAllString(markdownChildren(child, listDepth+1), "\n"))
+		item = strings.ReplaceAll(item, "\n\n", "\n")
+		out.WriteString(indent + marker + item + "\n")
+	}
+
+	if listDepth > 0 {
+		return "\n" + out.String()
+	}
+	return "\n\n" + out.String() + "\n"
+}
+
+// Converts a table to a Markdown table, using the first row as the header
+func markdownTable(node *html.Node) string {
+	rows := [][]string{}
+
+	var findRows func(node *html.Node)
+	findRows = func(node *html.Node) {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.DataAtom != atom.Tr {
+				findRows(child)
+				continue
+			}
+
+			cells := []string{}
+			for cell := child.FirstChild; cell != nil; cell = cell.NextSibling {
+				if cell.DataAtom == atom.Td || cell.DataAtom == atom.Th {
+					cells = append(cells, strings.ReplaceAll(singleLine(markdownChildren(cell, 0)), "|", `\|`))
+				}
+			}
+			rows = append(rows, cells)
+		}
+	}
+	findRows(node)
+
+	if len(rows) == 0 {
+		return ""
+	}
+
+	columns := 0
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	out := &strings.Builder{}
+	out.WriteString("\n\n")
+	for i, row := range rows {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		out.WriteString("| " + strings.Join(row, " | ") + " |\n")
+
+		if i == 0 {
+			out.WriteString("|" + strings.Repeat(" --- |", columns) + "\n")
+		}
+	}
+	out.WriteString("\n")
+
+	return out.String()
+}
+
+// Wraps inline text in a Markdown marker such as **, keeping surrounding spaces outside of the marker so it is still recognized
+func wrapInline(text string, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+
+	start := strings.Index(text, trimmed)
+	return text[:start] + marker + trimmed + marker + text[start+len(trimmed):]
+}
+
+// Replaces every run of whitespace with a single space, like a browser does when rendering text
+func collapseWhitespace(text string) string {
+	if strings.TrimSpace(text) == "" {
+		if text == "" {
+			return ""
+		}
+		return " "
+	}
+
+	collapsed := strings.Join(strings.Fields(text), " ")
+	if strings.TrimLeft(text, " \t\r\n") != text {
+		collapsed = " " + collapsed
+	}
+	if strings.TrimRight(text, " \t\r\n") != text {
+		collapsed += " "
+	}
+	return collapsed
+}
+
+// Joins the lines of a block so it can be used where Markdown only allows a single line, such as a heading or table cell
+func singleLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// Gets the text of a node and its children without any formatting
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+
+	out := &strings.Builder{}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		out.WriteString(textContent(child))
+	}
+	return out.String()
+}
+
+// Gets the value of an attribute, or an empty string if the node does not have it
+func attribute(node *html.Node, name string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == name {
+			return attr.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,210 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// How long a notification channel is requested for when ChangeWatcher.TTL is not set. Drive may return an earlier expiration.
+const defaultChannelTTL = 24 * time.Hour
+
+// How long to wait before trying to register a channel again after it fails
+const channelRetryDelay = time.Minute
+
+// The shortest time a channel is kept before it is renewed, so a channel that Drive says expires right away is not renewed in a
+// tight loop. Channels with a TTL shorter than twice this are renewed after half their TTL instead.
+const minChannelRenewalDelay = time.Minute
+
+// A DocumentSource that can send a notification to a webhook when items change
+type WatchSource interface {
+	ChangeSource
+
+	// Registers a channel that is notified when items change after the given page token
+	WatchChanges(ctx context.Context, pageToken string, channel *DriveChannel) (*DriveChannel, error)
+
+	// Stops notifications from being sent to a channel
+	StopChannel(ctx context.Context, channel *DriveChannel) error
+}
+
+// Keeps a Drive notification channel registered for the webhook at Address, and syncs the file cache whenever Drive sends a
+// notification to it. ChangeWatcher is also the http.Handler for the webhook.
+type ChangeWatcher struct {
+	FileService *FileService
+	Source      WatchSource
+
+	// The public URL of the webhook. Drive requires this to be an HTTPS URL.
+	Address string
+
+	// How long each channel is requested for
+	TTL time.Duration
+
+	// How long before a channel expires a new channel is registered. Defaults to a tenth of TTL.
+	RenewBefore time.Duration
+
+	mutex    sync.Mutex
+	channels map[string]*DriveChannel
+}
+
+// Registers a channel, and keeps registering a new one before the current channel expires, until ctx is cancelled
+func (w *ChangeWatcher) Start(ctx context.Context) {
+	go func() {
+		for {
+			channel, err := w.register(ctx)
+			if err != nil {
+				log.Printf("Could not register a Drive notification channel: %s", err)
+
+				select {
+				case <-time.After(channelRetryDelay):
+					continue
+				case <-ctx.Done():
+					w.stopAll()
+					return
+				}
+			}
+
+			select {
+			case <-time.After(time.Until(w.renewalTime(channel, time.Now()))):
+			case <-ctx.Done():
+				w.stopAll()
+				return
+			}
+		}
+	}()
+}
+
+// Registers a new channel and stops the channels it replaces
+func (w *ChangeWatcher) register(ctx context.Context) (*DriveChannel, error) {
+	ttl := w.ttl()
+
+	pageToken, err := w.Source.GetStartPageToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := newChannelToken()
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := w.Source.WatchChanges(ctx, pageToken, &DriveChannel{
+		ID:         uuid.NewString(),
+		Type:       "web_hook",
+		Address:    w.Address,
+		Token:      token,
+		Expiration: time.Now().Add(ttl).UnixMilli(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Drive does not send the token back, so keep the one that was generated
+	channel.Token = token
+
+	w.mutex.Lock()
+	previous := w.channels
+	w.channels = map[string]*DriveChannel{channel.ID: channel}
+	w.mutex.Unlock()
+
+	for _, old := range previous {
+		if err := w.Source.StopChannel(ctx, old); err != nil && err != ErrItemNotFound {
+			log.Printf("Could not stop Drive notification channel %s: %s", old.ID, err)
+		}
+	}
+
+	return channel, nil
+}
+
+// Gets how long each channel is requested for
+func (w *ChangeWatcher) ttl() time.Duration {
+	if w.TTL <= 0 {
+		return defaultChannelTTL
+	}
+
+	return w.TTL
+}
+
+// Gets the time a new channel should be registered to replace the given channel. When Drive did not return an expiration, or
+// returned one that has already passed, the channel is treated as expiring after the requested TTL.
+func (w *ChangeWatcher) renewalTime(channel *DriveChannel, now time.Time) time.Time {
+	ttl := w.ttl()
+
+	expiration := time.UnixMilli(channel.Expiration)
+	if channel.Expiration <= 0 || !expiration.After(now) {
+		expiration = now.Add(ttl)
+	}
+
+	renewBefore := w.RenewBefore
+	if renewBefore <= 0 {
+		renewBefore = expiration.Sub(now) / 10
+	}
+
+	minDelay := minChannelRenewalDelay
+	if minDelay > ttl/2 {
+		minDelay = ttl / 2
+	}
+
+	renewal := expiration.Add(-renewBefore)
+	if renewal.Before(now.Add(minDelay)) {
+		return now.Add(minDelay)
+	}
+
+	return renewal
+}
+
+// Stops every registered channel
+func (w *ChangeWatcher) stopAll() {
+	w.mutex.Lock()
+	channels := w.channels
+	w.channels = nil
+	w.mutex.Unlock()
+
+	for _, channel := range channels {
+		// The context that started the watcher has been cancelled, so give Drive a few seconds on a new one
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		w.Source.StopChannel(ctx, channel)
+		cancel()
+	}
+}
+
+// Handles a notification from Drive. Notifications that do not come from a registered channel with the right token are rejected.
+func (w *ChangeWatcher) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(rw, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.mutex.Lock()
+	channel := w.channels[r.Header.Get("X-Goog-Channel-ID")]
+	w.mutex.Unlock()
+
+	token := r.Header.Get("X-Goog-Channel-Token")
+	if channel == nil || subtle.ConstantTimeCompare([]byte(token), []byte(channel.Token)) != 1 {
+		http.Error(rw, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	// Drive sends a "sync" message when a channel is created, which does not mean anything has changed
+	if r.Header.Get("X-Goog-Resource-State") != "sync" {
+		w.FileService.TriggerSync()
+	}
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+// Creates a random secret that Drive sends back with every notification
+func newChannelToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
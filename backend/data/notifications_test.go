@@ -0,0 +1,178 @@
+package data
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestChangeWatcherRejectsForgedNotifications(t *testing.T) {
+	service := &FileService{}
+	watcher := &ChangeWatcher{FileService: service, channels: map[string]*DriveChannel{"channel": {ID: "channel", Token: "secret"}}}
+
+	notify := func(method string, channelId string, token string, state string) int {
+		req := httptest.NewRequest(method, "/drive/notifications", nil)
+		req.Header.Set("X-Goog-Channel-ID", channelId)
+		req.Header.Set("X-Goog-Channel-Token", token)
+		req.Header.Set("X-Goog-Resource-State", state)
+
+		res := httptest.NewRecorder()
+		watcher.ServeHTTP(res, req)
+		return res.Code
+	}
+
+	if code := notify(http.MethodPost, "channel", "forged", "change"); code != http.StatusForbidden {
+		t.Errorf("expected a forged token to be rejected, got %d", code)
+	}
+	if code := notify(http.MethodPost, "unknown", "secret", "change"); code != http.StatusForbidden {
+		t.Errorf("expected an unknown channel to be rejected, got %d", code)
+	}
+	if code := notify(http.MethodGet, "channel", "secret", "change"); code != http.StatusMethodNotAllowed {
+		t.Errorf("expected GET to be rejected, got %d", code)
+	}
+	if len(service.syncState.triggerChannel()) != 0 {
+		t.Fatal("expected rejected notifications not to trigger a sync")
+	}
+
+	if code := notify(http.MethodPost, "channel", "secret", "sync"); code != http.StatusOK {
+		t.Errorf("expected the sync message to be accepted, got %d", code)
+	}
+	if len(service.syncState.triggerChannel()) != 0 {
+		t.Fatal("expected the sync message not to trigger a sync")
+	}
+
+	if code := notify(http.MethodPost, "channel", "secret", "change"); code != http.StatusOK {
+		t.Errorf("expected a valid notification to be accepted, got %d", code)
+	}
+	if code := notify(http.MethodPost, "channel", "secret", "change"); code != http.StatusOK {
+		t.Errorf("expected a valid notification to be accepted, got %d", code)
+	}
+	if len(service.syncState.triggerChannel()) != 1 {
+		t.Error("expected valid notifications to trigger a single sync")
+	}
+}
+
+func TestChangeWatcherRenewsChannels(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	watcher := &ChangeWatcher{
+		FileService: &FileService{},
+		Source:      newTestDriveSource(drive),
+		Address:     "https://sop.example.edu/drive/notifications",
+		TTL:         300 * time.Millisecond,
+		RenewBefore: 250 * time.Millisecond,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	watcher.Start(ctx)
+
+	// Wait for the first channel to be replaced
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		watched, stopped := len(drive.Watched()), len(drive.Stopped())
+
+		if watched >= 2 && stopped >= 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the channel to be renewed, got %d registrations and %d stops", watched, stopped)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	first := drive.Watched()[0]
+	if first.Type != "web_hook" || first.Address != watcher.Address || len(first.Token) != 64 {
+		t.Errorf("unexpected channel registration: %#v", first)
+	}
+	if stopped := drive.Stopped(); stopped[0] != first.ID {
+		t.Errorf("expected the first channel to be stopped, got %s", stopped[0])
+	}
+
+	// Notifications for the replaced channel should no longer be accepted
+	req := httptest.NewRequest(http.MethodPost, "/drive/notifications", nil)
+	req.Header.Set("X-Goog-Channel-ID", first.ID)
+	req.Header.Set("X-Goog-Channel-Token", first.Token)
+	res := httptest.NewRecorder()
+	watcher.ServeHTTP(res, req)
+	if res.Code != http.StatusForbidden {
+		t.Errorf("expected the replaced channel to be rejected, got %d", res.Code)
+	}
+
+	// Every channel should be stopped once the watcher is cancelled
+	cancel()
+	deadline = time.Now().Add(2 * time.Second)
+	for {
+		watched, stopped := len(drive.Watched()), len(drive.Stopped())
+
+		if watched == stopped {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected every channel to be stopped, got %d registrations and %d stops", watched, stopped)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestChannelRenewalTime(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(offset time.Duration) int64 {
+		return now.Add(offset).UnixMilli()
+	}
+
+	tests := []struct {
+		name       string
+		watcher    *ChangeWatcher
+		expiration int64
+		expected   time.Duration
+	}{
+		{"a tenth before it expires", &ChangeWatcher{TTL: 10 * time.Hour}, at(10 * time.Hour), 9 * time.Hour},
+		{"configured time before it expires", &ChangeWatcher{TTL: 10 * time.Hour, RenewBefore: time.Hour}, at(5 * time.Hour), 4 * time.Hour},
+		{"no expiration", &ChangeWatcher{TTL: 10 * time.Hour}, 0, 9 * time.Hour},
+		{"no expiration or TTL", &ChangeWatcher{}, 0, defaultChannelTTL * 9 / 10},
+		{"already expired", &ChangeWatcher{TTL: 10 * time.Hour}, at(-time.Hour), 9 * time.Hour},
+		{"renewal time already passed", &ChangeWatcher{TTL: 10 * time.Hour, RenewBefore: time.Hour}, at(time.Second), minChannelRenewalDelay},
+		{"short TTL", &ChangeWatcher{TTL: 300 * time.Millisecond, RenewBefore: 250 * time.Millisecond}, at(300 * time.Millisecond), 150 * time.Millisecond},
+	}
+
+	for _, test := range tests {
+		renewal := test.watcher.renewalTime(&DriveChannel{Expiration: test.expiration}, now)
+		if delay := renewal.Sub(now); delay != test.expected {
+			t.Errorf("%s: expected a renewal after %s, got %s", test.name, test.expected, delay)
+		}
+	}
+}
+
+// A Drive source that registers channels without an expiration
+type noExpirationSource struct {
+	*DriveSource
+}
+
+func (s noExpirationSource) WatchChanges(ctx context.Context, pageToken string, channel *DriveChannel) (*DriveChannel, error) {
+	registered, err := s.DriveSource.WatchChanges(ctx, pageToken, channel)
+	if registered != nil {
+		registered.Expiration = 0
+	}
+
+	return registered, err
+}
+
+func TestChangeWatcherWaitsForChannelsWithoutExpiration(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	watcher := &ChangeWatcher{
+		FileService: &FileService{},
+		Source:      noExpirationSource{newTestDriveSource(drive)},
+		Address:     "https://sop.example.edu/drive/notifications",
+		TTL:         time.Hour,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watcher.Start(ctx)
+
+	time.Sleep(200 * time.Millisecond)
+	if watched := len(drive.Watched()); watched != 1 {
+		t.Errorf("expected the channel to be kept until its TTL is nearly over, got %d registrations", watched)
+	}
+}
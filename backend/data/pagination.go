@@ -0,0 +1,75 @@
+package data
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// The most files that can be returned in one page of a FileConnection
+const maxPageSize = 100
+
+// The position of a file in a sorted list of files. Key is the value the list is sorted by, and the ID breaks ties between
+// files with the same key, so the next page starts right after the file even when other files are added or removed.
+type fileCursor struct {
+	Key string `json:"k"`
+	ID  string `json:"i"`
+}
+
+// Encodes a cursor as an opaque string for clients
+func encodeFileCursor(cursor fileCursor) string {
+	encoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// Checks the page size and decodes the after cursor of a page request. Returns a nil cursor when after is nil.
+func parsePageRequest(ctx context.Context, first int, after *string) (*fileCursor, error) {
+	if first < 1 || first > maxPageSize {
+		return nil, errors.NewInputError(ctx, "first must be between 1 and 100.")
+	}
+
+	if after == nil {
+		return nil, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(*after)
+	if err != nil {
+		return nil, errors.NewInputError(ctx, "The after cursor is not valid.")
+	}
+
+	cursor := &fileCursor{}
+	if err := json.Unmarshal(decoded, cursor); err != nil || cursor.ID == "" {
+		return nil, errors.NewInputError(ctx, "The after cursor is not valid.")
+	}
+
+	return cursor, nil
+}
+
+// Creates a page of files from up to first+1 files, where the extra file only shows that there is a next page
+func newFileConnection(files []*model.File, first int, after *fileCursor, totalCount int, key func(file *model.File) string) *model.FileConnection {
+	connection := &model.FileConnection{
+		Edges:      []*model.FileEdge{},
+		PageInfo:   &model.PageInfo{HasPreviousPage: after != nil},
+		TotalCount: totalCount,
+	}
+
+	if len(files) > first {
+		files = files[:first]
+		connection.PageInfo.HasNextPage = true
+	}
+
+	for _, file := range files {
+		cursor := encodeFileCursor(fileCursor{Key: key(file), ID: file.ID})
+		connection.Edges = append(connection.Edges, &model.FileEdge{Cursor: cursor, Node: file})
+	}
+
+	if len(connection.Edges) > 0 {
+		connection.PageInfo.StartCursor = &connection.Edges[0].Cursor
+		connection.PageInfo.EndCursor = &connection.Edges[len(connection.Edges)-1].Cursor
+	}
+
+	return connection
+}
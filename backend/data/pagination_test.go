@@ -0,0 +1,72 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+func newTestFiles(count int) []*model.File {
+	files := []*model.File{}
+	for i := 0; i < count; i++ {
+		files = append(files, &model.File{ID: fmt.Sprintf("file-%d", i), Name: fmt.Sprintf("File %d", i)})
+	}
+
+	return files
+}
+
+func TestNewFileConnection(t *testing.T) {
+	name := func(file *model.File) string { return file.Name }
+
+	connection := newFileConnection(newTestFiles(3), 2, nil, 7, name)
+	if len(connection.Edges) != 2 || connection.Edges[1].Node.ID != "file-1" {
+		t.Fatalf("expected the first 2 files, got %#v", connection.Edges)
+	}
+	if !connection.PageInfo.HasNextPage || connection.PageInfo.HasPreviousPage || connection.TotalCount != 7 {
+		t.Errorf("expected a first page with a next page, got %#v", connection.PageInfo)
+	}
+	if connection.PageInfo.EndCursor == nil || *connection.PageInfo.EndCursor != connection.Edges[1].Cursor {
+		t.Errorf("expected the end cursor to be the cursor of the last file, got %v", connection.PageInfo.EndCursor)
+	}
+
+	// The end cursor decodes to the position of the last file in the page
+	cursor, err := parsePageRequest(context.Background(), 2, connection.PageInfo.EndCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor.Key != "File 1" || cursor.ID != "file-1" {
+		t.Errorf("expected a cursor after File 1, got %#v", cursor)
+	}
+
+	connection = newFileConnection(newTestFiles(2), 2, cursor, 7, name)
+	if len(connection.Edges) != 2 || connection.PageInfo.HasNextPage || !connection.PageInfo.HasPreviousPage {
+		t.Errorf("expected a last page, got %#v", connection.PageInfo)
+	}
+
+	connection = newFileConnection([]*model.File{}, 2, cursor, 0, name)
+	if len(connection.Edges) != 0 || connection.PageInfo.StartCursor != nil || connection.PageInfo.EndCursor != nil {
+		t.Errorf("expected an empty page without cursors, got %#v", connection.PageInfo)
+	}
+}
+
+func TestParsePageRequest(t *testing.T) {
+	ctx := context.Background()
+
+	cursor, err := parsePageRequest(ctx, 20, nil)
+	if err != nil || cursor != nil {
+		t.Errorf("expected no cursor for the first page, got %#v, %v", cursor, err)
+	}
+
+	_, err = parsePageRequest(ctx, 0, nil)
+	expectStatus(t, err, 400)
+
+	_, err = parsePageRequest(ctx, maxPageSize+1, nil)
+	expectStatus(t, err, 400)
+
+	for _, after := range []string{"not a cursor!", encodeFileCursor(fileCursor{Key: "File 1"})} {
+		_, err = parsePageRequest(ctx, 20, &after)
+		expectStatus(t, err, 400)
+	}
+}
@@ -0,0 +1,203 @@
+package data
+
+import (
+	"context"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// Determines whether items are inside the root folder by following their parents. Results are remembered, so checking many items
+// in the same folder only looks up each ancestor once.
+type rootChecker struct {
+	source DocumentSource
+	known  map[string]bool
+	items  map[string]*DriveFolderItem
+}
+
+func newRootChecker(source DocumentSource) *rootChecker {
+	return &rootChecker{source: source, known: map[string]bool{source.RootFolderID(): true}, items: map[string]*DriveFolderItem{}}
+}
+
+func (c *rootChecker) isInRoot(ctx context.Context, item *DriveFolderItem) (bool, error) {
+	if inRoot, ok := c.known[item.ID]; ok {
+		return inRoot, nil
+	}
+
+	// Guard against cycles while the ancestors are being checked
+	c.known[item.ID] = false
+
+	for _, parent := range item.Parents {
+		inRoot, ok := c.known[parent.ID]
+		if !ok {
+			parentItem, err := c.getItem(ctx, parent.ID)
+			if err == ErrItemNotFound {
+				// The parent is not shared with us, so it cannot be inside the root folder
+				c.known[parent.ID] = false
+				continue
+			} else if err != nil {
+				delete(c.known, item.ID)
+				return false, err
+			}
+
+			inRoot, err = c.isInRoot(ctx, parentItem)
+			if err != nil {
+				delete(c.known, item.ID)
+				return false, err
+			}
+		}
+
+		if inRoot {
+			c.known[item.ID] = true
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Determines whether an item is inside a folder in the root folder. Items that are only directly inside the root folder are not.
+func (c *rootChecker) isInFolder(ctx context.Context, item *DriveFolderItem) (bool, error) {
+	rootId := c.source.RootFolderID()
+	for _, parent := range item.Parents {
+		if parent.ID == rootId {
+			continue
+		}
+
+		parentItem, err := c.getItem(ctx, parent.ID)
+		if err == ErrItemNotFound {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+
+		inRoot, err := c.isInRoot(ctx, parentItem)
+		if err != nil {
+			return false, err
+		} else if inRoot {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Gets the folders between the root folder and an item, starting with the folder directly inside the root folder and ending with
+// the item's parent. The root folder and the item itself are left out. Returns ErrItemNotFound if the item is not inside the root folder.
+func (c *rootChecker) ancestors(ctx context.Context, item *DriveFolderItem) ([]*DriveFolderItem, error) {
+	rootId := c.source.RootFolderID()
+	if item.ID == rootId {
+		return []*DriveFolderItem{}, nil
+	}
+
+	// Items can have more than one parent, so follow the first parent that is inside the root folder
+	for _, parent := range item.Parents {
+		if parent.ID == rootId {
+			return []*DriveFolderItem{}, nil
+		}
+
+		parentItem, err := c.getItem(ctx, parent.ID)
+		if err == ErrItemNotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		inRoot, err := c.isInRoot(ctx, parentItem)
+		if err != nil {
+			return nil, err
+		} else if !inRoot {
+			continue
+		}
+
+		chain, err := c.ancestors(ctx, parentItem)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(chain, parentItem), nil
+	}
+
+	return nil, ErrItemNotFound
+}
+
+// Gets an item from the source, remembering it for the next time it is needed
+func (c *rootChecker) getItem(ctx context.Context, id string) (*DriveFolderItem, error) {
+	if item, ok := c.items[id]; ok {
+		return item, nil
+	}
+
+	item, err := c.source.GetItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.items[id] = item
+	return item, nil
+}
+
+// Gets an item by ID, making sure it is inside the root folder. Items outside the root folder are treated as if they do not exist,
+// so they cannot be read by guessing their ID.
+func (s *FileService) getItemInRoot(ctx context.Context, id string) (*DriveFolderItem, error) {
+	return newRootChecker(s.Source).getItemInRoot(ctx, id)
+}
+
+// Gets an item by ID like FileService.getItemInRoot, reusing the folders this checker has already looked up
+func (c *rootChecker) getItemInRoot(ctx context.Context, id string) (*DriveFolderItem, error) {
+	item, err := c.getItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	inRoot, err := c.isInRoot(ctx, item)
+	if err != nil {
+		return nil, err
+	} else if !inRoot {
+		return nil, ErrItemNotFound
+	}
+
+	return item, nil
+}
+
+// Gets the folders between the root folder and an item, starting with the folder directly inside the root folder and ending
+// with the folder that contains the item
+func (s *FileService) GetPath(ctx context.Context, id string) ([]*model.Folder, error) {
+	item, err := s.Source.GetItem(ctx, id)
+	if err == ErrItemNotFound {
+		return nil, errors.NewNotFoundError(ctx, "Oops! This item does not exist.")
+	} else if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving an item's path.", err)
+	}
+
+	chain, err := newRootChecker(s.Source).ancestors(ctx, item)
+	if err == ErrItemNotFound {
+		return nil, errors.NewNotFoundError(ctx, "Oops! This item does not exist.")
+	} else if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving an item's path.", err)
+	}
+
+	path := []*model.Folder{}
+	for _, ancestor := range chain {
+		folder := s.NewFolderModel()
+		folder.ID = ancestor.ID
+		folder.Name = ancestor.Name
+
+		path = append(path, folder)
+	}
+
+	return path, nil
+}
+
+// Gets the folder that contains an item, or nil if the item is directly inside the root folder
+func (s *FileService) GetParent(ctx context.Context, id string) (*model.Folder, error) {
+	path, err := s.GetPath(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(path) == 0 {
+		return nil, nil
+	}
+
+	return path[len(path)-1], nil
+}
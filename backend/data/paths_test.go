@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/drivetest"
+)
+
+func TestGetPath(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddFolder("protocols", "buffers", "Buffers")
+	drive.AddDocument("buffers", "pbs", "PBS", "2023-01-01T00:00:00.000Z")
+	drive.AddDocument("root", "safety", "Safety", "2023-01-01T00:00:00.000Z")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	path, err := service.GetPath(ctx, "pbs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(path) != 2 || path[0].ID != "protocols" || path[1].ID != "buffers" || path[1].Name != "Buffers" {
+		t.Errorf("expected the path Protocols > Buffers, got %#v", path)
+	}
+
+	parent, err := service.GetParent(ctx, "buffers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent == nil || parent.ID != "protocols" {
+		t.Errorf("expected Protocols to be the parent of Buffers, got %#v", parent)
+	}
+
+	parent, err = service.GetParent(ctx, "safety")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent != nil {
+		t.Errorf("expected no parent for a file in the root folder, got %#v", parent)
+	}
+}
+
+func TestGetPathFollowsParentInsideRoot(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("elsewhere", "personal", "Personal")
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddDocument("protocols", "pbs", "PBS", "2023-01-01T00:00:00.000Z")
+	drive.File("pbs").Parents = []*drivetest.Parent{{ID: "personal"}, {ID: "protocols"}}
+
+	path, err := newTestFileService(drive).GetPath(context.Background(), "pbs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(path) != 1 || path[0].ID != "protocols" {
+		t.Errorf("expected the path through the root folder, got %#v", path)
+	}
+}
+
+func TestItemsOutsideRootAreNotFound(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("elsewhere", "personal", "Personal")
+	drive.AddDocument("personal", "secret", "Secret", "2023-01-01T00:00:00.000Z")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	_, err := service.GetFileById(ctx, "secret")
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 404 {
+		t.Errorf("expected a not found error for a file outside the root folder, got %v", err)
+	}
+
+	_, err = service.GetFolderById(ctx, "personal")
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 404 {
+		t.Errorf("expected a not found error for a folder outside the root folder, got %v", err)
+	}
+
+	_, err = service.GetPath(ctx, "secret")
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 404 {
+		t.Errorf("expected a not found error for the path of a file outside the root folder, got %v", err)
+	}
+}
+
+func TestLocalSourcePath(t *testing.T) {
+	source := newTestLocalSource(t)
+	service := &FileService{Source: source}
+
+	path, err := service.GetPath(context.Background(), source.encodeID("Buffers/PBS.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(path) != 1 || path[0].Name != "Buffers" {
+		t.Errorf("expected the path Buffers, got %#v", path)
+	}
+}
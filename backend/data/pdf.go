@@ -0,0 +1,51 @@
+package data
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// Extracts the text layer of a PDF, one line per line of text on each page. Scanned PDFs only have text if they have been OCR'd.
+func extractPDFText(content []byte) (text string, err error) {
+	// The PDF reader panics on some malformed files, so treat a panic like any other unreadable file
+	defer func() {
+		if r := recover(); r != nil {
+			text = ""
+			err = fmt.Errorf("could not read PDF: %v", r)
+		}
+	}()
+
+	reader, err := pdf.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", err
+	}
+
+	pages := []string{}
+	for i := 1; i <= reader.NumPage(); i++ {
+		page := reader.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+
+		rows, err := page.GetTextByRow()
+		if err != nil {
+			return "", err
+		}
+
+		lines := []string{}
+		for _, row := range rows {
+			words := []string{}
+			for _, word := range row.Content {
+				words = append(words, word.S)
+			}
+			lines = append(lines, strings.Join(words, ""))
+		}
+
+		pages = append(pages, strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(pages, "\n\n"), nil
+}
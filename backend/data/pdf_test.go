@@ -0,0 +1,95 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// Builds a single page PDF that draws each line of text with the built-in Helvetica font
+func newTestPDF(lines ...string) []byte {
+	stream := &strings.Builder{}
+	stream.WriteString("BT /F1 12 Tf 72 720 Td\n")
+	for i, line := range lines {
+		if i > 0 {
+			stream.WriteString("0 -20 Td\n")
+		}
+		fmt.Fprintf(stream, "(%s) Tj\n", line)
+	}
+	stream.WriteString("ET")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", stream.Len(), stream.String()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+	}
+
+	out := &bytes.Buffer{}
+	out.WriteString("%PDF-1.4\n")
+	offsets := []int{}
+	for i, object := range objects {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return out.Bytes()
+}
+
+func TestExtractPDFText(t *testing.T) {
+	text, err := extractPDFText(newTestPDF("Centrifuge protocol", "Spin at 4000 rpm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(text, "Centrifuge protocol") || !strings.Contains(text, "Spin at 4000 rpm") {
+		t.Errorf("expected both lines in the text layer, got %q", text)
+	}
+}
+
+func TestExtractPDFTextRejectsInvalidFiles(t *testing.T) {
+	if _, err := extractPDFText([]byte("not a pdf")); err == nil {
+		t.Error("expected an error for a file that is not a PDF")
+	}
+}
+
+func TestPDFsAreListedAndIndexed(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "Equipment", "Centrifuge.pdf"), newTestPDF("Balance the rotor"))
+	service := &FileService{Source: &LocalSource{Root: root}}
+
+	folders, err := service.GetAllFolders(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents, err := service.GetFolderContents(context.Background(), folders[0].ID, model.FolderSortFieldName, model.SortOrderAsc, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, ok := contents[0].(*model.File)
+	if len(contents) != 1 || !ok || file.Name != "Centrifuge" || file.MimeType != pdfMimeType {
+		t.Fatalf("expected the Centrifuge PDF to be listed, got %#v", contents)
+	}
+
+	text, err := service.getFileText(context.Background(), file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(*text, "Balance the rotor") {
+		t.Errorf("expected the PDF's text layer to be indexed, got %q", *text)
+	}
+}
@@ -0,0 +1,113 @@
+package data
+
+import (
+	"context"
+	"sort"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// Gets every revision of a file, most recent first. Sources without a revision history have no revisions.
+func (s *FileService) GetFileRevisions(ctx context.Context, id string) ([]*model.Revision, error) {
+	revisionSource, ok := s.Source.(RevisionSource)
+	if !ok {
+		return []*model.Revision{}, nil
+	}
+
+	items, err := revisionSource.ListRevisions(ctx, id)
+	if err == ErrItemNotFound {
+		return nil, errors.NewNotFoundError(ctx, "Oops! This file does not exist.")
+	} else if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving the revision history.", err)
+	}
+
+	revisions := []*model.Revision{}
+	for _, item := range items {
+		revisions = append(revisions, &model.Revision{
+			ID:        item.ID,
+			FileID:    id,
+			Timestamp: item.LastModified,
+			Author:    item.LastModifiedBy,
+		})
+	}
+
+	// Sort revisions from most to least recent
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return isNewer(revisions[i].Timestamp, revisions[j].Timestamp)
+	})
+
+	return revisions, nil
+}
+
+// Gets a single file by ID as it was at a revision. The content of the returned file is exported from the revision.
+func (s *FileService) GetFileRevision(ctx context.Context, id string, revisionId string) (*model.File, error) {
+	file, err := s.GetFileById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionSource, ok := s.Source.(RevisionSource)
+	if !ok {
+		return nil, errors.NewNotFoundError(ctx, "Oops! This revision does not exist.")
+	}
+
+	revision, err := revisionSource.GetRevision(ctx, id, revisionId)
+	if err == ErrItemNotFound {
+		return nil, errors.NewNotFoundError(ctx, "Oops! This revision does not exist.")
+	} else if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while retrieving a revision.", err)
+	}
+
+	file.Revision = &revision.ID
+	file.LastUpdated = revision.LastModified
+	file.LastModifiedBy = revision.LastModifiedBy
+
+	return file, nil
+}
+
+// Gets a file by ID, or the file as it was at a revision when a revision ID is given
+func (s *FileService) getFileAtRevision(ctx context.Context, id string, revisionId *string) (*model.File, error) {
+	if revisionId == nil {
+		return s.GetFileById(ctx, id)
+	}
+
+	return s.GetFileRevision(ctx, id, *revisionId)
+}
+
+// Exports the content of a file in the format with the given mime type, from the file's revision if it has one
+func (s *FileService) exportFileContent(ctx context.Context, file *model.File, mimeType string) ([]byte, error) {
+	// Drive cannot export DOCX files that were uploaded without converting them, so their HTML is converted from the file itself
+	if file.MimeType == docxMimeType && mimeType != docxMimeType {
+		if mimeType != htmlMimeType {
+			return nil, ErrUnsupportedFormat
+		}
+
+		content, err := s.downloadFileContent(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+
+		return docxToHTML(content)
+	}
+
+	if file.Revision == nil {
+		return s.Source.ExportContent(ctx, file.ID, mimeType)
+	}
+
+	revisionSource, ok := s.Source.(RevisionSource)
+	if !ok {
+		return nil, ErrItemNotFound
+	}
+
+	return revisionSource.ExportRevision(ctx, file.ID, *file.Revision, mimeType)
+}
+
+// Downloads the original content of a file, from the file's revision if it has one
+func (s *FileService) downloadFileContent(ctx context.Context, file *model.File) ([]byte, error) {
+	if file.Revision == nil {
+		return s.Source.DownloadContent(ctx, file.ID)
+	}
+
+	return s.exportFileContent(ctx, file, file.MimeType)
+}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/drivetest"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Adds a revision of a document that can be exported as HTML and plain text
+func addRevision(drive *drivetest.Server, fileId string, id string, modified string, author string, html string, text string) {
+	drive.AddRevision(fileId, &drivetest.Revision{
+		ID:                    id,
+		MimeType:              drivetest.DocumentMimeType,
+		ModifiedDate:          modified,
+		LastModifyingUserName: author,
+	}, map[string][]byte{htmlMimeType: []byte(html), textMimeType: []byte(text)})
+}
+
+func TestGetFileRevisions(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddDocument("root", "doc", "Centrifuge", "2023-03-01T00:00:00.000Z")
+	addRevision(drive, "doc", "1", "2023-01-01T00:00:00.000Z", "Alice", "<p>Spin at 3000 rpm</p>", "Spin at 3000 rpm")
+	addRevision(drive, "doc", "2", "2023-03-01T00:00:00.000Z", "Bob", "<p>Spin at 4000 rpm</p>", "Spin at 4000 rpm")
+	service := newTestFileService(drive)
+
+	revisions, err := service.GetFileRevisions(context.Background(), "doc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(revisions) != 2 || revisions[0].ID != "2" || revisions[1].ID != "1" {
+		t.Fatalf("expected the revisions from most to least recent, got %#v", revisions)
+	}
+	if revisions[1].FileID != "doc" || revisions[1].Author != "Alice" || revisions[1].Timestamp != "2023-01-01T00:00:00.000Z" {
+		t.Errorf("unexpected revision %#v", revisions[1])
+	}
+}
+
+func TestGetFileRevisionContent(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddDocument("root", "doc", "Centrifuge", "2023-03-01T00:00:00.000Z")
+	addRevision(drive, "doc", "1", "2023-01-01T00:00:00.000Z", "Alice", "<p>Spin at 3000 rpm</p>", "Spin at 3000 rpm")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+	revisionId := "1"
+
+	file, err := service.GetFileRevision(ctx, "doc", revisionId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Revision == nil || *file.Revision != "1" || file.LastModifiedBy != "Alice" || file.LastUpdated != "2023-01-01T00:00:00.000Z" {
+		t.Errorf("expected the file as it was at revision 1, got %#v", file)
+	}
+
+	content, err := service.GetFileContent(ctx, "doc", &revisionId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != "<p>Spin at 3000 rpm</p>" {
+		t.Errorf("unexpected content %q", content)
+	}
+
+	download, err := service.DownloadFile(ctx, "doc", &revisionId, model.DownloadFormatTxt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(download.Content) != "Spin at 3000 rpm" {
+		t.Errorf("unexpected download %q", download.Content)
+	}
+
+	// The revision has no DOCX export link
+	_, err = service.DownloadFile(ctx, "doc", &revisionId, model.DownloadFormatDocx)
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 400 {
+		t.Errorf("expected an input error, got %v", err)
+	}
+
+	_, err = service.GetFileRevision(ctx, "doc", "missing")
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 404 {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestGetFileRevisionsWithoutHistory(t *testing.T) {
+	source := newTestLocalSource(t)
+	service := &FileService{Source: source}
+
+	revisions, err := service.GetFileRevisions(context.Background(), source.encodeID("Safety.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 0 {
+		t.Errorf("expected no revisions, got %d", len(revisions))
+	}
+}
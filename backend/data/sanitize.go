@@ -0,0 +1,251 @@
+package data
+
+import (
+	"bytes"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// The path of the image proxy that images in sanitized content are loaded through
+const imageProxyPath = "/images/proxy"
+
+// Elements that are kept in sanitized content. Other elements are removed, but their text is kept.
+var allowedElements = map[atom.Atom]bool{
+	atom.P: true, atom.Br: true, atom.Hr: true, atom.Blockquote: true, atom.Pre: true, atom.Code: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Ul: true, atom.Ol: true, atom.Li: true,
+	atom.Strong: true, atom.B: true, atom.Em: true, atom.I: true, atom.U: true, atom.S: true, atom.Sub: true, atom.Sup: true,
+	atom.A: true, atom.Img: true,
+	atom.Table: true, atom.Thead: true, atom.Tbody: true, atom.Tr: true, atom.Td: true, atom.Th: true,
+}
+
+// Elements that are removed along with everything inside them
+var droppedElements = map[atom.Atom]bool{
+	atom.Head: true, atom.Title: true, atom.Meta: true, atom.Link: true, atom.Base: true, atom.Style: true,
+	atom.Script: true, atom.Noscript: true, atom.Template: true,
+	atom.Iframe: true, atom.Frame: true, atom.Frameset: true, atom.Object: true, atom.Embed: true, atom.Applet: true,
+	atom.Form: true, atom.Input: true, atom.Button: true, atom.Select: true, atom.Textarea: true,
+	atom.Svg: true, atom.Math: true,
+}
+
+// Elements that never have children or an end tag
+var voidElements = map[atom.Atom]bool{atom.Br: true, atom.Hr: true, atom.Img: true}
+
+// Matches the paths of Google Docs, Sheets, Slides and Drive file links, such as /document/d/<id>/edit
+var googleFilePath = regexp.MustCompile(`^/(?:document|spreadsheets|presentation|file)/d/([\w-]+)`)
+
+// Matches data URLs of raster images. SVG images are left out, since they can contain scripts.
+var imageDataURL = regexp.MustCompile(`^data:image/(?:png|jpeg|gif|webp);base64,[A-Za-z0-9+/=\s]+$`)
+
+// Matches the blank lines between paragraphs of plain text
+var paragraphBreak = regexp.MustCompile(`\n\s*\n`)
+
+// Matches attribute values that are whole numbers
+var wholeNumber = regexp.MustCompile(`^[0-9]{1,4}$`)
+
+// Sanitizes an HTML document, such as a Google Docs export, so it can be shown inside the organizer. Only an allow-list of elements
+// and attributes is kept, which removes Google's inline styles, classes and scripts. Links to SOPs are rewritten to their page in the
+// organizer and images are loaded through the image proxy.
+func sanitizeHTML(document []byte) (string, error) {
+	root, err := html.Parse(bytes.NewReader(document))
+	if err != nil {
+		return "", err
+	}
+
+	out := &strings.Builder{}
+	sanitizeChildren(out, root)
+
+	return strings.TrimSpace(out.String()), nil
+}
+
+func sanitizeChildren(out *strings.Builder, node *html.Node) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		sanitizeNode(out, child)
+	}
+}
+
+func sanitizeNode(out *strings.Builder, node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		out.WriteString(html.EscapeString(node.Data))
+		return
+	case html.DocumentNode:
+		sanitizeChildren(out, node)
+		return
+	case html.ElementNode:
+	default:
+		// Comments and doctypes
+		return
+	}
+
+	if droppedElements[node.DataAtom] {
+		return
+	}
+
+	// Google Docs marks bold and italic text with inline styles, so keep the formatting as tags once the styles are removed
+	if node.DataAtom == atom.Span {
+		style := strings.ReplaceAll(attribute(node, "style"), " ", "")
+		bold := strings.Contains(style, "font-weight:700") || strings.Contains(style, "font-weight:bold")
+		italic := strings.Contains(style, "font-style:italic")
+
+		if bold {
+			out.WriteString("<strong>")
+		}
+		if italic {
+			out.WriteString("<em>")
+		}
+		sanitizeChildren(out, node)
+		if italic {
+			out.WriteString("</em>")
+		}
+		if bold {
+			out.WriteString("</strong>")
+		}
+		return
+	}
+
+	if !allowedElements[node.DataAtom] {
+		sanitizeChildren(out, node)
+		return
+	}
+
+	attributes, ok := sanitizeAttributes(node)
+	if !ok {
+		sanitizeChildren(out, node)
+		return
+	}
+
+	tag := node.DataAtom.String()
+	out.WriteString("<" + tag)
+	for _, attr := range attributes {
+		out.WriteString(" " + attr.Key + `="` + html.EscapeString(attr.Val) + `"`)
+	}
+	out.WriteString(">")
+
+	if voidElements[node.DataAtom] {
+		return
+	}
+
+	sanitizeChildren(out, node)
+	out.WriteString("</" + tag + ">")
+}
+
+// Gets the attributes of an element that are kept, rewriting links and image sources. Returns false if the element should be
+// removed because its link or image cannot be used.
+func sanitizeAttributes(node *html.Node) ([]html.Attribute, bool) {
+	attributes := []html.Attribute{}
+
+	switch node.DataAtom {
+	case atom.A:
+		href := rewriteLink(attribute(node, "href"))
+		if href == "" {
+			return nil, false
+		}
+
+		attributes = append(attributes, html.Attribute{Key: "href", Val: href})
+		if !strings.HasPrefix(href, "/") && !strings.HasPrefix(href, "#") {
+			attributes = append(attributes, html.Attribute{Key: "target", Val: "_blank"}, html.Attribute{Key: "rel", Val: "noopener noreferrer"})
+		}
+	case atom.Img:
+		src := proxyImageURL(attribute(node, "src"))
+		if src == "" {
+			return nil, false
+		}
+
+		attributes = append(attributes, html.Attribute{Key: "src", Val: src})
+		if alt := attribute(node, "alt"); alt != "" {
+			attributes = append(attributes, html.Attribute{Key: "alt", Val: alt})
+		}
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		// Keep heading IDs, since a document's table of contents links to them
+		if id := attribute(node, "id"); id != "" {
+			attributes = append(attributes, html.Attribute{Key: "id", Val: id})
+		}
+	case atom.Td, atom.Th:
+		for _, name := range []string{"colspan", "rowspan"} {
+			if value := attribute(node, name); wholeNumber.MatchString(value) {
+				attributes = append(attributes, html.Attribute{Key: name, Val: value})
+			}
+		}
+	case atom.Ol:
+		if start := attribute(node, "start"); wholeNumber.MatchString(start) {
+			attributes = append(attributes, html.Attribute{Key: "start", Val: start})
+		}
+	}
+
+	return attributes, true
+}
+
+// Rewrites a link so links to Google Docs, Sheets, Slides and Drive files open the file's page in the organizer. Links in Google Docs
+// exports go through a Google redirect, which is removed. Returns an empty string for links that are not http, https, mailto or
+// links to a heading in the same document.
+func rewriteLink(href string) string {
+	href = strings.TrimSpace(href)
+	if strings.HasPrefix(href, "#") {
+		return href
+	}
+
+	link, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+
+	switch strings.ToLower(link.Scheme) {
+	case "mailto":
+		return link.String()
+	case "http", "https":
+	default:
+		return ""
+	}
+
+	host := strings.ToLower(link.Hostname())
+
+	if (host == "www.google.com" || host == "google.com") && link.Path == "/url" {
+		if target := link.Query().Get("q"); target != "" && target != href {
+			return rewriteLink(target)
+		}
+	}
+
+	if host == "docs.google.com" || host == "drive.google.com" {
+		if match := googleFilePath.FindStringSubmatch(link.Path); match != nil {
+			return "/file/" + match[1]
+		}
+		if id := link.Query().Get("id"); link.Path == "/open" && id != "" {
+			return "/file/" + url.PathEscape(id)
+		}
+	}
+
+	return link.String()
+}
+
+// Gets the URL to load an image from. Images on the web are loaded through the image proxy, so they are loaded from this server
+// instead of Google. Returns an empty string for images that cannot be loaded.
+func proxyImageURL(src string) string {
+	src = strings.TrimSpace(src)
+	if imageDataURL.MatchString(src) {
+		return src
+	}
+
+	link, err := url.Parse(src)
+	if err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+		return ""
+	}
+
+	return imageProxyPath + "?src=" + url.QueryEscape(src)
+}
+
+// Converts plain text to HTML, with a paragraph for each block of text separated by a blank line
+func textToHTML(text string) []byte {
+	out := &bytes.Buffer{}
+	for _, paragraph := range paragraphBreak.Split(strings.TrimSpace(text), -1) {
+		if paragraph == "" {
+			continue
+		}
+		out.WriteString("<p>" + strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>") + "</p>\n")
+	}
+	return out.Bytes()
+}
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestSanitizeHTML(t *testing.T) {
+	document := `<html><head><meta charset="utf-8"><style>.c1{color:red}</style><script>alert(1)</script></head>
+<body class="c5 doc-content" style="max-width:468pt">
+<h1 id="h.abc" class="c3" style="padding-top:20pt"><span class="c2">Centrifuge</span></h1>
+<p class="c1" onclick="alert(1)"><span style="font-weight:700">Balance</span> the <span style="font-style:italic">rotor</span>.</p>
+<p><a href="https://www.google.com/url?q=https://docs.google.com/document/d/1AbC-d_E/edit&amp;sa=D&amp;ust=1">Buffers SOP</a>
+<a href="https://drive.google.com/open?id=xyz">Manual</a>
+<a href="https://example.com/spec?a=1&amp;b=2">Spec</a>
+<a href="javascript:alert(1)">Click</a>
+<a href="#h.abc">Top</a></p>
+<p><img src="https://lh3.googleusercontent.com/abc?key=1" alt="Rotor" style="width:100px" onerror="alert(1)"><img src="file:///etc/passwd"></p>
+<table><tr><td colspan="2" style="border:1px">Speed</td><td rowspan="x">4000</td></tr></table>
+<iframe src="https://example.com"></iframe><!-- comment -->
+</body></html>`
+
+	content, err := sanitizeHTML([]byte(document))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<h1 id="h.abc">Centrifuge</h1>
+<p><strong>Balance</strong> the <em>rotor</em>.</p>
+<p><a href="/file/1AbC-d_E">Buffers SOP</a>
+<a href="/file/xyz">Manual</a>
+<a href="https://example.com/spec?a=1&amp;b=2" target="_blank" rel="noopener noreferrer">Spec</a>
+Click
+<a href="#h.abc">Top</a></p>
+<p><img src="/images/proxy?src=https%3A%2F%2Flh3.googleusercontent.com%2Fabc%3Fkey%3D1" alt="Rotor"></p>
+<table><tbody><tr><td colspan="2">Speed</td><td>4000</td></tr></tbody></table>`
+	if content != expected {
+		t.Errorf("unexpected content:\n%s\nexpected:\n%s", content, expected)
+	}
+}
+
+func TestGetFileContent(t *testing.T) {
+	source := newTestLocalSource(t)
+	writeTestFile(t, filepath.Join(source.Root, "Centrifuge.pdf"), newTestPDF("Balance the rotor"))
+	service := &FileService{Source: source}
+
+	content, err := service.GetFileContent(context.Background(), source.encodeID("Buffers/PBS.md"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != "<h1>PBS</h1>\n<ol>\n<li>Add salt</li>\n<li>Stir &amp; wait</li>\n</ol>" {
+		t.Errorf("unexpected content %q", content)
+	}
+
+	// PDFs cannot be exported as HTML, so their text is shown instead
+	content, err = service.GetFileContent(context.Background(), source.encodeID("Centrifuge.pdf"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content != "<p>Balance the rotor</p>" {
+		t.Errorf("unexpected content %q", content)
+	}
+}
+
+func TestTextToHTML(t *testing.T) {
+	if got := string(textToHTML("Balance the rotor\nClose the lid\n\n<Spin>")); got != "<p>Balance the rotor<br>Close the lid</p>\n<p>&lt;Spin&gt;</p>\n" {
+		t.Errorf("unexpected HTML %q", got)
+	}
+}
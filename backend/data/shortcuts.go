@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+	"log"
+)
+
+// Replaces the shortcuts in a folder listing with the items they point to, marked with IsShortcut so clients can tell them apart.
+// Shortcuts to items that are outside the root folder, in the trash, or not shared with the service account are left out, as
+// are shortcuts to items that are already in the listing.
+func (s *FileService) resolveShortcuts(ctx context.Context, items []*DriveFolderItem) ([]*DriveFolderItem, error) {
+	listed := map[string]bool{}
+	for _, item := range items {
+		if item.Type != shortcutMimeType {
+			listed[item.ID] = true
+		}
+	}
+
+	// Shortcuts in the same folder often point into the same folders, so they share the folders that were already looked up
+	checker := newRootChecker(s.Source)
+
+	resolved := []*DriveFolderItem{}
+	for _, item := range items {
+		if item.Type != shortcutMimeType {
+			resolved = append(resolved, item)
+			continue
+		}
+
+		if item.ShortcutDetails == nil || listed[item.ShortcutDetails.TargetID] {
+			continue
+		}
+
+		target, err := checker.getItemInRoot(ctx, item.ShortcutDetails.TargetID)
+		if err == ErrItemNotFound || stderrors.Is(err, ErrDrivePermissionDenied) {
+			log.Printf("Skipping the shortcut %s because its target %s is outside the root folder or cannot be read: %s", item.ID, item.ShortcutDetails.TargetID, err)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if target.Labels.Trashed {
+			continue
+		}
+
+		// Copy the target, so the item returned by the source is not changed
+		shortcut := *target
+		shortcut.IsShortcut = true
+
+		listed[target.ID] = true
+		resolved = append(resolved, &shortcut)
+	}
+
+	return resolved, nil
+}
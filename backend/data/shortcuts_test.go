@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/drivetest"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// Creates a fake Drive where the Safety folder has shortcuts to items inside and outside the root folder
+func newShortcutTestDrive(t *testing.T) *drivetest.Server {
+	drive := newFakeDrive(t, 2)
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddDocument("protocols", "pcr", "PCR", "2023-01-01T00:00:00.000Z")
+	drive.AddDocument("protocols", "restricted", "Restricted", "2023-01-01T00:00:00.000Z")
+	drive.Forbid("restricted")
+	drive.AddFolder("elsewhere", "personal", "Personal")
+	drive.AddDocument("personal", "secret", "Secret", "2023-01-01T00:00:00.000Z")
+
+	drive.AddFolder("root", "safety", "Safety")
+	drive.AddDocument("safety", "gloves", "Gloves", "2023-01-01T00:00:00.000Z")
+	drive.AddShortcut("safety", "pcr-shortcut", "Shortcut to PCR", "pcr")
+	drive.AddShortcut("safety", "pcr-shortcut-2", "Another shortcut to PCR", "pcr")
+	drive.AddShortcut("safety", "protocols-shortcut", "Shortcut to Protocols", "protocols")
+	drive.AddShortcut("safety", "gloves-shortcut", "Shortcut to Gloves", "gloves")
+	drive.AddShortcut("safety", "secret-shortcut", "Shortcut to Secret", "secret")
+	drive.AddShortcut("safety", "restricted-shortcut", "Shortcut to Restricted", "restricted")
+	drive.AddShortcut("safety", "missing-shortcut", "Shortcut to a deleted file", "missing")
+
+	return drive
+}
+
+func TestGetFolderContentsResolvesShortcuts(t *testing.T) {
+	service := newTestFileService(newShortcutTestDrive(t))
+
+	contents, err := service.GetFolderContents(context.Background(), "safety", model.FolderSortFieldName, model.SortOrderAsc, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(contents) != 3 {
+		t.Fatalf("expected Gloves and the targets of the shortcuts to PCR and Protocols, got %#v", contents)
+	}
+	if file, ok := contents[0].(*model.File); !ok || file.ID != "gloves" || file.IsShortcut {
+		t.Errorf("expected Gloves first, without a shortcut to itself, got %#v", contents[0])
+	}
+	if file, ok := contents[1].(*model.File); !ok || file.ID != "pcr" || file.Name != "PCR" || !file.IsShortcut {
+		t.Errorf("expected PCR once, marked as a shortcut, got %#v", contents[1])
+	}
+	if folder, ok := contents[2].(*model.Folder); !ok || folder.ID != "protocols" || !folder.IsShortcut {
+		t.Errorf("expected the Protocols folder, marked as a shortcut, got %#v", contents[2])
+	}
+
+	// Filters apply to the targets of shortcuts
+	contents, err = service.GetFolderContents(context.Background(), "safety", model.FolderSortFieldName, model.SortOrderAsc, []model.FolderItemType{model.FolderItemTypeFolder}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contents) != 1 {
+		t.Errorf("expected only the Protocols folder, got %#v", contents)
+	}
+}
+
+func TestTraverseFoldersReturnsEachFileOnce(t *testing.T) {
+	drive := newShortcutTestDrive(t)
+	drive.AddDocument("safety", "sds", "SDS", "2023-01-01T00:00:00.000Z")
+	drive.AddFile("protocols", drive.File("sds"))
+
+	files, folderErrors, err := newTestFileService(drive).traverseFolders(context.Background(), []string{"root"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folderErrors) != 0 {
+		t.Fatalf("expected every folder to be listed, got %v", folderErrors)
+	}
+
+	ids := []string{}
+	for _, file := range files {
+		ids = append(ids, file.ID)
+	}
+	expected := []string{"pcr", "restricted", "sds", "gloves"}
+	if len(ids) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, ids)
+	}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, ids)
+			break
+		}
+	}
+}
+
+func TestResolveShortcutsLooksUpEachFolderOnce(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddFolder("protocols", "buffers", "Buffers")
+	drive.AddDocument("buffers", "pbs", "PBS", "2023-01-01T00:00:00.000Z")
+	drive.AddDocument("buffers", "tris", "Tris", "2023-01-01T00:00:00.000Z")
+	service := newTestFileService(drive)
+
+	shortcuts := []*DriveFolderItem{
+		{ID: "pbs-shortcut", Name: "Shortcut to PBS", Type: shortcutMimeType, ShortcutDetails: &DriveShortcutDetails{TargetID: "pbs"}},
+		{ID: "tris-shortcut", Name: "Shortcut to Tris", Type: shortcutMimeType, ShortcutDetails: &DriveShortcutDetails{TargetID: "tris"}},
+	}
+
+	requests := drive.Requests()
+	resolved, err := service.resolveShortcuts(context.Background(), shortcuts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resolved) != 2 || resolved[0].ID != "pbs" || resolved[1].ID != "tris" {
+		t.Fatalf("expected PBS and Tris, got %#v", resolved)
+	}
+
+	// One request for each target, and one for each folder between them and the root folder
+	if made := drive.Requests() - requests; made != 4 {
+		t.Errorf("expected 4 requests, got %d", made)
+	}
+}
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/db"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// How long old snapshots of a file's text are kept. The most recent snapshot of each file is always kept, and old snapshots are
+// kept forever when both limits are 0.
+type SnapshotRetention struct {
+	// The most snapshots kept for each file, or 0 for no limit
+	MaxVersions int
+
+	// How long a snapshot is kept after it was saved, or 0 for no limit
+	MaxAge time.Duration
+}
+
+// Determines if a new snapshot of a file's text should be saved, given the version and hash of its most recent snapshot. A new
+// version is only recorded when the text has changed, or when the file has no snapshots yet (a latest version of 0).
+func needsSnapshot(latestVersion int, latestHash string, contentHash string) bool {
+	return latestVersion == 0 || latestHash != contentHash
+}
+
+// Gets the SHA-256 hash of a file's text, which is compared to find out if the text has changed
+func hashContents(contents string) string {
+	hash := sha256.Sum256([]byte(contents))
+	return hex.EncodeToString(hash[:])
+}
+
+// Gets every snapshot of a file's text, most recent first
+func (s *FileService) GetFileSnapshots(ctx context.Context, id string) ([]*model.Snapshot, error) {
+	rows, err := db.DB.Query("SELECT version, content_hash, captured_at, last_updated, last_modified_by FROM file_snapshot WHERE file_id = $1 ORDER BY version DESC;", id)
+	if err != nil {
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving snapshots.", err)
+	}
+	defer rows.Close()
+
+	snapshots := []*model.Snapshot{}
+
+	for rows.Next() {
+		snapshot := &model.Snapshot{}
+		var capturedAt time.Time
+		var lastUpdated sql.NullTime
+		if err := rows.Scan(&snapshot.Version, &snapshot.ContentHash, &capturedAt, &lastUpdated, &snapshot.LastModifiedBy); err != nil {
+			return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving snapshots.", err)
+		}
+		snapshot.CapturedAt = capturedAt.UTC().Format(time.RFC3339)
+		snapshot.LastUpdated = formatCacheTimestamp(lastUpdated)
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
+// Gets the text of a snapshot, or nil if the snapshot does not exist
+func (s *FileService) getSnapshotText(ctx context.Context, id string, version int) (*string, error) {
+	var contents string
+	row := db.DB.QueryRow("SELECT contents FROM file_snapshot WHERE file_id = $1 AND version = $2;", id, version)
+	if err := row.Scan(&contents); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while retrieving a snapshot.", err)
+	}
+
+	return &contents, nil
+}
+
+// Gets the snapshot version number from a version such as "snapshot:3". Returns false if the version does not refer to a
+// numbered snapshot.
+func parseSnapshotVersion(version string) (int, bool) {
+	if !strings.HasPrefix(version, snapshotVersion+":") {
+		return 0, false
+	}
+
+	parsed, err := strconv.Atoi(strings.TrimPrefix(version, snapshotVersion+":"))
+	if err != nil || parsed < 1 {
+		return 0, false
+	}
+
+	return parsed, true
+}
+
+// Deletes the snapshots that are older than the retention policy allows, keeping the most recent snapshot of each file
+func (s *FileService) pruneSnapshots(ctx context.Context) error {
+	retention := s.SnapshotRetention
+	if retention.MaxVersions <= 0 && retention.MaxAge <= 0 {
+		return nil
+	}
+
+	var cutoff sql.NullTime
+	if retention.MaxAge > 0 {
+		cutoff = sql.NullTime{Time: time.Now().UTC().Add(-retention.MaxAge), Valid: true}
+	}
+
+	_, err := db.DB.ExecContext(ctx, `DELETE FROM file_snapshot s USING (SELECT file_id, MAX(version) AS latest FROM file_snapshot GROUP BY file_id) l
+		WHERE s.file_id = l.file_id AND s.version < l.latest AND (($1 > 0 AND s.version <= l.latest - $1) OR s.captured_at < $2);`,
+		retention.MaxVersions,
+		cutoff,
+	)
+	if err != nil {
+		return errors.NewInternalError(ctx, "An unexpected error occurred while removing old snapshots.", err)
+	}
+
+	return nil
+}
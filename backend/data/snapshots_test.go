@@ -0,0 +1,166 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/db"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+func TestParseSnapshotVersion(t *testing.T) {
+	tests := map[string]int{"snapshot:1": 1, "snapshot:12": 12}
+	for version, expected := range tests {
+		if number, ok := parseSnapshotVersion(version); !ok || number != expected {
+			t.Errorf("expected %s to be snapshot %d, got %d", version, expected, number)
+		}
+	}
+
+	for _, version := range []string{"snapshot", "snapshot:", "snapshot:0", "snapshot:-1", "snapshot:two", "1"} {
+		if _, ok := parseSnapshotVersion(version); ok {
+			t.Errorf("expected %s not to be a numbered snapshot", version)
+		}
+	}
+}
+
+func TestHashContents(t *testing.T) {
+	if hashContents("Spin at 4000 rpm") != hashContents("Spin at 4000 rpm") {
+		t.Error("expected the same text to have the same hash")
+	}
+	if hashContents("Spin at 4000 rpm") == hashContents("Spin at 3000 rpm") {
+		t.Error("expected different text to have different hashes")
+	}
+	if len(hashContents("")) != 64 {
+		t.Errorf("expected a hex encoded SHA-256 hash, got %s", hashContents(""))
+	}
+}
+
+func TestNeedsSnapshot(t *testing.T) {
+	hash := hashContents("Spin at 4000 rpm")
+
+	if !needsSnapshot(0, "", hash) {
+		t.Error("expected the first snapshot of a file to be saved")
+	}
+	if needsSnapshot(3, hash, hash) {
+		t.Error("expected no new snapshot when the text has not changed")
+	}
+	if !needsSnapshot(3, hashContents("Spin at 3000 rpm"), hash) {
+		t.Error("expected a new snapshot when the text has changed")
+	}
+}
+
+// Gets the version numbers of a file's snapshots, most recent first
+func snapshotVersions(t *testing.T, service *FileService, id string) []int {
+	snapshots, err := service.GetFileSnapshots(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	versions := []int{}
+	for _, snapshot := range snapshots {
+		versions = append(versions, snapshot.Version)
+	}
+
+	return versions
+}
+
+func TestSaveFileCacheOnlySnapshotsChangedText(t *testing.T) {
+	newTestDB(t)
+	service := &FileService{}
+	ctx := context.Background()
+	file := &model.File{ID: "pcr", Name: "PCR", LastUpdated: "2023-01-01T00:00:00.000Z", Owners: []*model.FileOwner{}}
+
+	for _, contents := range []string{"Spin at 4000 rpm", "Spin at 4000 rpm", "Spin at 3000 rpm", "Spin at 3000 rpm"} {
+		contents := contents
+		if err := service.saveFileCache(ctx, file, &contents); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if versions := snapshotVersions(t, service, "pcr"); !reflect.DeepEqual(versions, []int{2, 1}) {
+		t.Errorf("expected a version for each change to the text, got %v", versions)
+	}
+
+	text, err := service.getSnapshotText(ctx, "pcr", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text == nil || *text != "Spin at 4000 rpm" {
+		t.Errorf("expected the first version to keep the original text, got %v", text)
+	}
+}
+
+func TestSaveFileCacheConcurrently(t *testing.T) {
+	newTestDB(t)
+	service := &FileService{}
+	file := &model.File{ID: "pcr", Name: "PCR", LastUpdated: "2023-01-01T00:00:00.000Z", Owners: []*model.FileOwner{}}
+
+	// Each save has different text, so each needs its own version
+	const saves = 8
+	errs := make(chan error, saves)
+	for i := 0; i < saves; i++ {
+		contents := fmt.Sprintf("Spin at %d rpm", 1000*(i+1))
+		go func() {
+			errs <- service.saveFileCache(context.Background(), file, &contents)
+		}()
+	}
+	for i := 0; i < saves; i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+
+	if versions := snapshotVersions(t, service, "pcr"); !reflect.DeepEqual(versions, []int{8, 7, 6, 5, 4, 3, 2, 1}) {
+		t.Errorf("expected a version for each save, got %v", versions)
+	}
+}
+
+func TestPruneSnapshots(t *testing.T) {
+	tests := []struct {
+		name      string
+		retention SnapshotRetention
+		expected  map[string][]int
+	}{
+		{"no limits", SnapshotRetention{}, map[string][]int{"pcr": {4, 3, 2, 1}, "safety": {2, 1}}},
+		{"version limit", SnapshotRetention{MaxVersions: 2}, map[string][]int{"pcr": {4, 3}, "safety": {2, 1}}},
+		{"age limit without a version limit", SnapshotRetention{MaxAge: 24 * time.Hour}, map[string][]int{"pcr": {4, 3}, "safety": {2}}},
+		{"both limits", SnapshotRetention{MaxVersions: 1, MaxAge: 24 * time.Hour}, map[string][]int{"pcr": {4}, "safety": {2}}},
+		{"age limit that keeps everything", SnapshotRetention{MaxAge: 365 * 24 * time.Hour}, map[string][]int{"pcr": {4, 3, 2, 1}, "safety": {2, 1}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			newTestDB(t)
+			service := &FileService{SnapshotRetention: test.retention}
+			now := time.Now().UTC()
+
+			// Safety has not changed in a while, but its latest snapshot is always kept
+			ages := map[string][]time.Duration{
+				"pcr":    {96 * time.Hour, 72 * time.Hour, 2 * time.Hour, time.Hour},
+				"safety": {96 * time.Hour, 72 * time.Hour},
+			}
+			for id, fileAges := range ages {
+				for i, age := range fileAges {
+					_, err := db.DB.Exec("INSERT INTO file_snapshot (file_id, version, content_hash, contents, captured_at) VALUES ($1, $2, $3, $4, $5);",
+						id, i+1, hashContents(id), id, now.Add(-age))
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			if err := service.pruneSnapshots(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			for id, expected := range test.expected {
+				if versions := snapshotVersions(t, service, id); !reflect.DeepEqual(versions, expected) {
+					t.Errorf("expected %s to have versions %v, got %v", id, expected, versions)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"log"
+	"strings"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Mime types of the items that a DocumentSource can return
+const (
+	folderMimeType   = "application/vnd.google-apps.folder"
+	htmlMimeType     = "text/html"
+	markdownMimeType = "text/markdown"
+	docxMimeType     = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	pdfMimeType      = "application/pdf"
+	shortcutMimeType = "application/vnd.google-apps.shortcut"
+
+	spreadsheetMimeType  = "application/vnd.google-apps.spreadsheet"
+	presentationMimeType = "application/vnd.google-apps.presentation"
+	xlsxMimeType         = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	textMimeType         = "text/plain"
+)
+
+// Returned by a DocumentSource when the requested item does not exist
+var ErrItemNotFound = stderrors.New("item not found")
+
+// Returned by a DocumentSource when an item cannot be exported in the requested format
+var ErrUnsupportedFormat = stderrors.New("unsupported export format")
+
+// Returned by a ChangeSource when the page token has expired or is not recognized
+var ErrInvalidPageToken = stderrors.New("invalid page token")
+
+// Returned by an UploadSource when the folder already has an item with the same name
+var ErrItemExists = stderrors.New("item already exists")
+
+// Converts an error from a DocumentSource to an error for the client. A missing item becomes a not found error, and errors that
+// mean Drive is busy, unavailable or not shared with the service account get a message that explains what went wrong. Any other
+// error is an internal error with the given message.
+func sourceError(ctx context.Context, publicMessage string, err error) *gqlerror.Error {
+	if gqlErr, ok := err.(*gqlerror.Error); ok {
+		return gqlErr
+	}
+
+	switch {
+	case stderrors.Is(err, ErrItemNotFound):
+		return errors.NewNotFoundError(ctx, "Oops! This item does not exist.")
+	case stderrors.Is(err, ErrDriveQuotaExceeded):
+		log.Printf("%s Drive's usage limit was reached: %v", publicMessage, err)
+		return errors.NewUnavailableError(ctx, "Google Drive's usage limit has been reached. Please try again in a few minutes.")
+	case stderrors.Is(err, ErrDriveUnavailable):
+		return errors.NewUnavailableError(ctx, "Google Drive is not responding. Please try again in a few minutes.")
+	case stderrors.Is(err, ErrDrivePermissionDenied):
+		log.Printf("%s Drive denied permission: %v", publicMessage, err)
+		return errors.NewForbiddenError(ctx, "The organizer does not have permission to open this item in Google Drive. Ask an admin to share it with the organizer's service account.")
+	}
+
+	return errors.NewInternalError(ctx, publicMessage, err)
+}
+
+// A DocumentSource is where the folders and SOP documents served by the FileService are stored
+type DocumentSource interface {
+	// Gets the ID of the folder that contains every SOP
+	RootFolderID() string
+
+	// Gets every item in a folder
+	ListFolder(ctx context.Context, folderId string) ([]*DriveFolderItem, error)
+
+	// Gets a single item by ID. Returns ErrItemNotFound if the item does not exist
+	GetItem(ctx context.Context, id string) (*DriveFolderItem, error)
+
+	// Exports the content of a document in the format with the given mime type, such as text/html. Returns
+	// ErrUnsupportedFormat if the document cannot be exported in that format
+	ExportContent(ctx context.Context, id string, mimeType string) ([]byte, error)
+
+	// Downloads the original content of a file that is stored as is, such as a PDF
+	DownloadContent(ctx context.Context, id string) ([]byte, error)
+}
+
+// A DocumentSource that can list the items that changed since a point in time, so the file cache can be updated without
+// listing every folder
+type ChangeSource interface {
+	// Gets a page token that represents the current point in time
+	GetStartPageToken(ctx context.Context) (string, error)
+
+	// Gets every change since the given page token, along with the page token to use for the next call. Returns
+	// ErrInvalidPageToken if the page token can no longer be used
+	ListChanges(ctx context.Context, pageToken string) ([]*DriveChange, string, error)
+}
+
+// A DocumentSource that keeps the revision history of each file
+type RevisionSource interface {
+	// Gets every revision of a file
+	ListRevisions(ctx context.Context, id string) ([]*DriveRevision, error)
+
+	// Gets a single revision of a file. Returns ErrItemNotFound if the revision does not exist
+	GetRevision(ctx context.Context, id string, revisionId string) (*DriveRevision, error)
+
+	// Exports the content of a file as it was at a revision, in the format with the given mime type. Returns
+	// ErrUnsupportedFormat if the revision cannot be exported in that format
+	ExportRevision(ctx context.Context, id string, revisionId string, mimeType string) ([]byte, error)
+}
+
+// A DocumentSource that new files can be added to
+type UploadSource interface {
+	// Creates a file in a folder from the given content. When convert is true, the file is converted to the matching Google Docs
+	// type, such as a Google Doc for a DOCX file. Returns ErrItemExists if the file cannot have the same name as another item.
+	UploadFile(ctx context.Context, folderId string, name string, mimeType string, content io.Reader, convert bool) (*DriveFolderItem, error)
+}
+
+// A DocumentSource whose folders can be reorganized. Items keep their IDs when they are renamed or moved.
+type EditSource interface {
+	// Creates a folder inside another folder
+	CreateFolder(ctx context.Context, parentId string, name string) (*DriveFolderItem, error)
+
+	// Changes the name of a folder or file
+	RenameItem(ctx context.Context, id string, name string) (*DriveFolderItem, error)
+
+	// Moves a folder or file into a folder, removing it from every folder it was in before
+	MoveItem(ctx context.Context, id string, folderId string) (*DriveFolderItem, error)
+
+	// Moves a folder or file to the trash, where it can still be restored
+	TrashItem(ctx context.Context, id string) error
+}
+
+// Determines if an item with the given mime type is a folder
+func isFolderType(mimeType string) bool {
+	return mimeType == folderMimeType
+}
+
+// Determines if an item with the given mime type is a document that can be exported
+func isDocumentType(mimeType string) bool {
+	return strings.Contains(mimeType, "document") || mimeType == htmlMimeType || mimeType == markdownMimeType
+}
+
+// Determines if an item with the given mime type is shown as a file
+func isFileType(mimeType string) bool {
+	return isDocumentType(mimeType) || mimeType == pdfMimeType || mimeType == spreadsheetMimeType || mimeType == presentationMimeType
+}
@@ -0,0 +1,191 @@
+package data
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The namespace of the elements in an XLSX workbook
+const spreadsheetNamespace = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+
+// Extracts the cell values of every sheet in an XLSX workbook. Cells are separated by tabs and rows by new lines, and empty rows are left out.
+func extractSpreadsheetText(xlsx []byte) (string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(xlsx), int64(len(xlsx)))
+	if err != nil {
+		return "", err
+	}
+
+	// Text cells refer to the workbook's table of shared strings, which is left out when there are no text cells
+	sharedStrings := []string{}
+	if file, err := archive.Open("xl/sharedStrings.xml"); err == nil {
+		sharedStrings, err = readSharedStrings(file)
+		file.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Sort the sheets by number, so sheet10.xml comes after sheet9.xml
+	sheets := []string{}
+	for _, file := range archive.File {
+		if path.Dir(file.Name) == "xl/worksheets" && strings.HasSuffix(file.Name, ".xml") {
+			sheets = append(sheets, file.Name)
+		}
+	}
+	sort.SliceStable(sheets, func(i, j int) bool {
+		return sheetNumber(sheets[i]) < sheetNumber(sheets[j])
+	})
+
+	texts := []string{}
+	for _, name := range sheets {
+		file, err := archive.Open(name)
+		if err != nil {
+			return "", err
+		}
+
+		text, err := readSheet(file, sharedStrings)
+		file.Close()
+		if err != nil {
+			return "", err
+		}
+
+		if text != "" {
+			texts = append(texts, text)
+		}
+	}
+
+	return strings.Join(texts, "\n\n"), nil
+}
+
+// Gets the number of a worksheet from its file name, such as 2 for xl/worksheets/sheet2.xml
+func sheetNumber(name string) int {
+	number, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(path.Base(name), "sheet"), ".xml"))
+	if err != nil {
+		return 0
+	}
+
+	return number
+}
+
+// Reads the table of shared strings. A string can be split into several runs of differently formatted text, which are joined together.
+func readSharedStrings(r io.Reader) ([]string, error) {
+	sharedStrings := []string{}
+	current := &strings.Builder{}
+	inText := false
+
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return sharedStrings, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space != spreadsheetNamespace {
+				continue
+			}
+
+			switch t.Name.Local {
+			case "si":
+				current.Reset()
+			case "t":
+				inText = true
+			}
+		case xml.CharData:
+			if inText {
+				current.Write(t)
+			}
+		case xml.EndElement:
+			if t.Name.Space != spreadsheetNamespace {
+				continue
+			}
+
+			switch t.Name.Local {
+			case "si":
+				sharedStrings = append(sharedStrings, current.String())
+			case "t":
+				inText = false
+			}
+		}
+	}
+}
+
+// Reads the cell values of a single worksheet
+func readSheet(r io.Reader, sharedStrings []string) (string, error) {
+	lines := []string{}
+	cells := []string{}
+	value := &strings.Builder{}
+	cellType := ""
+	inValue := false
+
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return strings.Join(lines, "\n"), nil
+		} else if err != nil {
+			return "", err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space != spreadsheetNamespace {
+				continue
+			}
+
+			switch t.Name.Local {
+			case "row":
+				cells = []string{}
+			case "c":
+				value.Reset()
+				cellType = ""
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "t" {
+						cellType = attr.Value
+					}
+				}
+			case "v", "t":
+				inValue = true
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		case xml.EndElement:
+			if t.Name.Space != spreadsheetNamespace {
+				continue
+			}
+
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				text := value.String()
+				if cellType == "s" {
+					index, err := strconv.Atoi(text)
+					if err != nil || index < 0 || index >= len(sharedStrings) {
+						text = ""
+					} else {
+						text = sharedStrings[index]
+					}
+				}
+				if text != "" {
+					cells = append(cells, text)
+				}
+			case "row":
+				if len(cells) > 0 {
+					lines = append(lines, strings.Join(cells, "\t"))
+				}
+			}
+		}
+	}
+}
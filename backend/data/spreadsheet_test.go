@@ -0,0 +1,51 @@
+package data
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+)
+
+func newTestXLSX(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	for name, body := range files {
+		file, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>` + body))
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestExtractSpreadsheetText(t *testing.T) {
+	xlsx := newTestXLSX(t, map[string]string{
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><si><t>Reagent</t></si><si><r><t>Amount </t></r><r><t>(g)</t></r></si><si><t>NaCl</t></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>` +
+			`<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2"><v>8.77</v></c></row>` +
+			`<row r="3"></row></sheetData></worksheet>`,
+		"xl/worksheets/sheet10.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row r="1"><c r="A1" t="inlineStr"><is><t>Last sheet</t></is></c></row></sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml":  `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row r="1"><c r="A1" t="str"><v>Total</v></c></row></sheetData></worksheet>`,
+	})
+
+	text, err := extractSpreadsheetText(xlsx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Reagent\tAmount (g)\nNaCl\t8.77\n\nTotal\n\nLast sheet"
+	if text != expected {
+		t.Errorf("expected %q, got %q", expected, text)
+	}
+}
+
+func TestExtractSpreadsheetTextRejectsInvalidFiles(t *testing.T) {
+	if _, err := extractSpreadsheetText([]byte("not a workbook")); err == nil {
+		t.Error("expected an error for a file that is not a workbook")
+	}
+}
@@ -0,0 +1,674 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	strip "github.com/grokify/html-strip-tags-go"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// How often the file cache is synced when no interval is given
+const defaultSyncInterval = 15 * time.Minute
+
+// The sync_state key of the Drive changes page token
+const changesPageTokenKey = "drive_changes_page_token"
+
+// The sync_state key of the IDs of the documents that could not be refreshed by the last sync, as a JSON array
+const retryFilesKey = "retry_file_ids"
+
+// Reasons a file was pruned from the cache, as shown in the prune history
+const (
+	pruneReasonDeleted = "Deleted"
+	pruneReasonTrashed = "Moved to the trash"
+	pruneReasonMoved   = "Moved out of the root folder"
+	pruneReasonMissing = "No longer found in the root folder"
+	pruneReasonUnfiled = "Moved out of its folder into the root folder"
+)
+
+// The state of the background job that keeps the file cache in sync with the document source
+type syncState struct {
+	// Held for the entire duration of a sync, so that only one sync runs at a time
+	running sync.Mutex
+
+	// Protects status
+	mutex  sync.Mutex
+	status model.SyncStatus
+
+	// Receives a value when a sync should run before the next interval
+	trigger     chan struct{}
+	triggerOnce sync.Once
+}
+
+func (state *syncState) triggerChannel() chan struct{} {
+	state.triggerOnce.Do(func() {
+		state.trigger = make(chan struct{}, 1)
+	})
+
+	return state.trigger
+}
+
+// Starts syncing the file cache in the background. A sync runs immediately, after every interval and whenever TriggerSync is
+// called, until ctx is cancelled.
+func (s *FileService) StartSync(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultSyncInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			status := s.SyncFiles(ctx)
+			log.Printf("Synced file cache: %d documents updated in %dms with %d errors", status.DocumentsUpdated, *status.DurationMs, len(status.Errors))
+
+			select {
+			case <-ticker.C:
+			case <-s.syncState.triggerChannel():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Asks the background job started by StartSync to sync as soon as possible. Calls made while a sync is waiting to start are
+// combined into a single sync.
+func (s *FileService) TriggerSync() {
+	select {
+	case s.syncState.triggerChannel() <- struct{}{}:
+	default:
+	}
+}
+
+// Gets the status of the most recent sync
+func (s *FileService) GetSyncStatus(ctx context.Context) (*model.SyncStatus, error) {
+	s.syncState.mutex.Lock()
+	defer s.syncState.mutex.Unlock()
+
+	status := s.syncState.status
+	status.Errors = append([]string{}, s.syncState.status.Errors...)
+
+	return &status, nil
+}
+
+// The outcome of a sync
+type syncResult struct {
+	updated int
+	pruned  int
+
+	// Errors that keep the changes page token from being advanced, such as a folder that could not be listed
+	errors []error
+
+	// Documents that could not be refreshed. They do not hold back the page token, and are retried by the next sync instead.
+	failed []*failedFile
+}
+
+// A document that could not be refreshed during a sync
+type failedFile struct {
+	id  string
+	err error
+}
+
+func (r *syncResult) addError(err error) {
+	r.errors = append(r.errors, err)
+}
+
+func (r *syncResult) addFailedFile(id string, err error) {
+	r.failed = append(r.failed, &failedFile{id: id, err: err})
+}
+
+// Gets the IDs of the documents that could not be refreshed
+func (r *syncResult) failedIds() []string {
+	ids := []string{}
+	for _, file := range r.failed {
+		ids = append(ids, file.id)
+	}
+
+	return ids
+}
+
+// Updates the file cache with every document in the source that has been added or changed since it was cached, and prunes
+// documents that are no longer in the root folder. If a sync is already running, this waits for it to finish and then runs
+// another one. Errors are recorded in the returned status instead of stopping the sync, so one unreadable document does not keep
+// the rest of the cache from being updated.
+func (s *FileService) SyncFiles(ctx context.Context) *model.SyncStatus {
+	s.syncState.running.Lock()
+	defer s.syncState.running.Unlock()
+
+	start := time.Now()
+	lastRun := start.UTC().Format(time.RFC3339)
+
+	s.syncState.mutex.Lock()
+	s.syncState.status.Running = true
+	s.syncState.status.LastRun = &lastRun
+	s.syncState.mutex.Unlock()
+
+	result := &syncResult{errors: []error{}, failed: []*failedFile{}}
+	s.syncFiles(ctx, result)
+	if err := s.pruneSnapshots(ctx); err != nil {
+		result.addError(err)
+	}
+
+	status := newSyncStatus(lastRun, time.Since(start), result)
+
+	s.syncState.mutex.Lock()
+	s.syncState.status = status
+	s.syncState.mutex.Unlock()
+
+	return &status
+}
+
+// Creates the status of a finished sync, with an error message for every error and for every document that could not be refreshed
+func newSyncStatus(lastRun string, duration time.Duration, result *syncResult) model.SyncStatus {
+	durationMs := int(duration.Milliseconds())
+	status := model.SyncStatus{
+		Running:          false,
+		LastRun:          &lastRun,
+		DurationMs:       &durationMs,
+		DocumentsUpdated: result.updated,
+		DocumentsPruned:  result.pruned,
+		Errors:           []string{},
+	}
+	for _, err := range result.errors {
+		status.Errors = append(status.Errors, syncErrorMessage(err))
+	}
+	for _, file := range result.failed {
+		status.Errors = append(status.Errors, syncErrorMessage(file.err))
+	}
+
+	return status
+}
+
+// Brings the file cache up to date. When the source can list changes, only the items that changed since the last sync are
+// checked. Otherwise, and whenever the saved page token is no longer valid, every folder is walked instead.
+func (s *FileService) syncFiles(ctx context.Context, result *syncResult) {
+	changeSource, ok := s.Source.(ChangeSource)
+	if !ok {
+		s.fullSync(ctx, result)
+		return
+	}
+
+	pageToken, err := s.getSyncValue(ctx, changesPageTokenKey)
+	if err != nil {
+		result.addError(err)
+		return
+	}
+
+	if pageToken != "" {
+		err := s.incrementalSync(ctx, changeSource, pageToken, result)
+		if err != ErrInvalidPageToken {
+			return
+		}
+
+		log.Printf("The saved Drive changes page token is no longer valid, so every folder will be synced")
+	}
+
+	// Get the new page token before walking the folders, so changes made during the walk are picked up by the next sync
+	newPageToken, err := changeSource.GetStartPageToken(ctx)
+	if err != nil {
+		result.addError(fmt.Errorf("could not get a changes page token: %w", err))
+		return
+	}
+
+	s.fullSync(ctx, result)
+	s.saveSyncProgress(ctx, newPageToken, result)
+}
+
+// Saves the page token to list changes from in the next sync, along with the documents that the next sync should retry. Nothing is
+// saved when the sync had errors other than documents that could not be refreshed, so the same changes are listed again.
+func (s *FileService) saveSyncProgress(ctx context.Context, pageToken string, result *syncResult) {
+	if len(result.errors) > 0 {
+		return
+	}
+
+	// Save the documents to retry first, so they are not lost if the page token is saved but this is not
+	retryIds, err := json.Marshal(result.failedIds())
+	if err != nil {
+		result.addError(err)
+		return
+	}
+	if err := s.setSyncValue(ctx, retryFilesKey, string(retryIds)); err != nil {
+		result.addError(err)
+		return
+	}
+
+	if err := s.setSyncValue(ctx, changesPageTokenKey, pageToken); err != nil {
+		result.addError(err)
+	}
+}
+
+// Gets the documents that could not be refreshed by the last sync as changes, so they are refreshed again. Documents that no
+// longer exist are returned as deleted.
+func (s *FileService) getRetryChanges(ctx context.Context, result *syncResult) []*DriveChange {
+	value, err := s.getSyncValue(ctx, retryFilesKey)
+	if err != nil {
+		result.addError(err)
+		return nil
+	}
+
+	ids := []string{}
+	if value != "" {
+		if err := json.Unmarshal([]byte(value), &ids); err != nil {
+			result.addError(fmt.Errorf("could not read the documents to retry: %w", err))
+			return nil
+		}
+	}
+
+	return s.retryChanges(ctx, ids, result)
+}
+
+// Gets the current version of each document as a change. Documents that cannot be read are added to the result as failed again.
+func (s *FileService) retryChanges(ctx context.Context, ids []string, result *syncResult) []*DriveChange {
+	changes := []*DriveChange{}
+	for _, id := range ids {
+		item, err := s.Source.GetItem(ctx, id)
+		if err == ErrItemNotFound {
+			changes = append(changes, &DriveChange{FileID: id, Deleted: true})
+			continue
+		} else if err != nil {
+			result.addFailedFile(id, fmt.Errorf("could not retry %s: %w", id, err))
+			continue
+		}
+
+		changes = append(changes, &DriveChange{FileID: id, File: item})
+	}
+
+	return changes
+}
+
+// Walks every folder, refreshes each document that is stale in the cache and prunes cached documents that were not found. The
+// folder tree built by the walk replaces the cached folder tree.
+func (s *FileService) fullSync(ctx context.Context, result *syncResult) {
+	snapshot, folderErrors, err := s.buildFolderTree(ctx)
+	if err != nil {
+		result.addError(err)
+		return
+	}
+	for _, folderError := range folderErrors {
+		result.addError(folderError)
+	}
+
+	files := snapshot.files()
+	s.refreshStaleFiles(ctx, files, result)
+
+	// Documents in folders that could not be listed would look like they had been removed, so only prune after a complete walk
+	if len(folderErrors) == 0 {
+		s.folderTree.set(snapshot)
+		s.pruneMissingFiles(ctx, files, result)
+	}
+}
+
+// Applies the changes made since the given page token to the cache, along with the documents that the last sync could not refresh.
+// The saved page token is only advanced when every folder and item could be read, so those changes are listed again by the next
+// sync. Documents that could not be refreshed do not hold back the page token, and are saved to be retried instead. Returns
+// ErrInvalidPageToken if the page token can no longer be used.
+func (s *FileService) incrementalSync(ctx context.Context, changeSource ChangeSource, pageToken string, result *syncResult) error {
+	changes, newPageToken, err := changeSource.ListChanges(ctx, pageToken)
+	if err == ErrInvalidPageToken {
+		return err
+	} else if err != nil {
+		result.addError(fmt.Errorf("could not list changes: %w", err))
+		return nil
+	}
+
+	// Retries go first, so a newer change to the same document takes their place
+	retries := s.getRetryChanges(ctx, result)
+	if len(result.errors) > 0 {
+		return nil
+	}
+	changes = append(retries, changes...)
+
+	plan, planErrors := s.planChanges(ctx, changes)
+	for _, err := range planErrors {
+		result.addError(err)
+	}
+
+	if plan.fullSync {
+		// A folder left the root folder, and the cache does not know which documents were inside it. The cached folder tree is
+		// dropped first, since a full sync only replaces it when every folder could be listed.
+		s.folderTree.set(nil)
+		s.fullSync(ctx, result)
+	} else {
+		// Check every document in folders that changed, since they may have been moved into the root folder
+		walked, err := s.walkFolders(ctx, plan.folders)
+		if err != nil {
+			result.addError(err)
+			return nil
+		}
+		files, folderErrors := collectFiles(walked)
+		for _, folderError := range folderErrors {
+			result.addError(folderError)
+		}
+
+		s.refreshStaleFiles(ctx, append(plan.refresh, files...), result)
+
+		// A full sync rebuilds the folder tree itself
+		s.folderTree.applyChanges(plan, walked)
+	}
+
+	for _, item := range plan.remove {
+		s.pruneFile(ctx, item.id, item.reason, result)
+	}
+
+	s.saveSyncProgress(ctx, newPageToken, result)
+
+	return nil
+}
+
+// The updates to the file cache that are needed to apply a list of changes
+type changePlan struct {
+	// Documents in folders in the root folder that may be stale
+	refresh []*model.File
+
+	// Items that were deleted, trashed or moved out of the root folder
+	remove []*prunedItem
+
+	// IDs of folders in the root folder that were added, renamed or moved
+	folders []string
+
+	// Folders and documents in the root folder that were added, renamed or moved, along with the folders they are in
+	changed []*changedItem
+
+	// Indicates a folder outside of the root folder changed, so every folder needs to be walked to find documents that left
+	fullSync bool
+}
+
+// An item whose place in the folder tree may have changed
+type changedItem struct {
+	item      model.FolderItem
+	parentIds []string
+}
+
+// An item that should be removed from the file cache
+type prunedItem struct {
+	id     string
+	reason string
+}
+
+// Works out how the file cache needs to be updated for a list of changes. Only the most recent change to each item is used.
+func (s *FileService) planChanges(ctx context.Context, changes []*DriveChange) (*changePlan, []error) {
+	plan := &changePlan{refresh: []*model.File{}, remove: []*prunedItem{}, folders: []string{}, changed: []*changedItem{}}
+	syncErrors := []error{}
+	checker := newRootChecker(s.Source)
+
+	latest := map[string]int{}
+	for i, change := range changes {
+		latest[change.FileID] = i
+	}
+
+	for i, change := range changes {
+		if latest[change.FileID] != i {
+			continue
+		}
+
+		if change.Deleted || change.File == nil {
+			plan.remove = append(plan.remove, &prunedItem{id: change.FileID, reason: pruneReasonDeleted})
+			continue
+		}
+
+		if change.File.Labels.Trashed {
+			plan.remove = append(plan.remove, &prunedItem{id: change.FileID, reason: pruneReasonTrashed})
+			continue
+		}
+
+		inRoot, err := checker.isInRoot(ctx, change.File)
+		if err != nil {
+			syncErrors = append(syncErrors, fmt.Errorf("could not find the folder of %s (%s): %w", change.File.Name, change.FileID, err))
+			continue
+		}
+
+		switch {
+		case !inRoot && isFolderType(change.File.Type):
+			plan.fullSync = true
+		case !inRoot:
+			plan.remove = append(plan.remove, &prunedItem{id: change.FileID, reason: pruneReasonMoved})
+		case change.FileID == s.Source.RootFolderID():
+			// Walking the root folder would cache the documents directly inside it, which a full sync leaves out
+		case isFolderType(change.File.Type):
+			plan.folders = append(plan.folders, change.FileID)
+			plan.addChanged(s.newFolderItems([]*DriveFolderItem{change.File}), change.File)
+		default:
+			// Like a full sync, only cache documents that are inside a folder, and not directly inside the root folder
+			inFolder, err := checker.isInFolder(ctx, change.File)
+			if err != nil {
+				syncErrors = append(syncErrors, fmt.Errorf("could not find the folder of %s (%s): %w", change.File.Name, change.FileID, err))
+				continue
+			} else if !inFolder {
+				plan.remove = append(plan.remove, &prunedItem{id: change.FileID, reason: pruneReasonUnfiled})
+				continue
+			}
+
+			items := s.newFolderItems([]*DriveFolderItem{change.File})
+			for _, item := range items {
+				if file, ok := item.(*model.File); ok {
+					plan.refresh = append(plan.refresh, file)
+				}
+			}
+			plan.addChanged(items, change.File)
+		}
+	}
+
+	return plan, syncErrors
+}
+
+// Adds the folder item made from a changed Drive item to the plan, along with the IDs of its parents. Items of unsupported types
+// are not in the folder tree, so nothing is added for them.
+func (p *changePlan) addChanged(items []model.FolderItem, driveItem *DriveFolderItem) {
+	if len(items) == 0 {
+		return
+	}
+
+	parentIds := []string{}
+	for _, parent := range driveItem.Parents {
+		parentIds = append(parentIds, parent.ID)
+	}
+
+	p.changed = append(p.changed, &changedItem{item: items[0], parentIds: parentIds})
+}
+
+// Refreshes each file that is not in the cache or has changed since it was cached
+func (s *FileService) refreshStaleFiles(ctx context.Context, files []*model.File, result *syncResult) {
+	cachedFiles, err := s.getCachedFiles(ctx)
+	if err != nil {
+		result.addError(err)
+		return
+	}
+
+	for _, file := range files {
+		if ctx.Err() != nil {
+			result.addError(ctx.Err())
+			return
+		}
+
+		if !isStale(file, cachedFiles[file.ID]) {
+			continue
+		}
+
+		if err := s.refreshFileCache(ctx, file); err != nil {
+			result.addFailedFile(file.ID, fmt.Errorf("could not update %s (%s): %s", file.Name, file.ID, syncErrorMessage(err)))
+			continue
+		}
+
+		// Remember the new version, in case the same file is listed again
+		cachedFiles[file.ID] = file
+		result.updated++
+	}
+}
+
+// Prunes every cached file that is not in the given list of files found in the root folder
+func (s *FileService) pruneMissingFiles(ctx context.Context, files []*model.File, result *syncResult) {
+	cachedFiles, err := s.getCachedFiles(ctx)
+	if err != nil {
+		result.addError(err)
+		return
+	}
+
+	found := map[string]bool{}
+	for _, file := range files {
+		found[file.ID] = true
+	}
+
+	for id := range cachedFiles {
+		if !found[id] {
+			s.pruneFile(ctx, id, pruneReasonMissing, result)
+		}
+	}
+}
+
+// Removes a file from the cache and records it in the prune history
+func (s *FileService) pruneFile(ctx context.Context, id string, reason string, result *syncResult) {
+	title, err := s.archiveFileCache(ctx, id, reason)
+	if err != nil {
+		result.addError(err)
+		return
+	}
+
+	// The file may never have been cached, such as a document that was created and deleted between syncs
+	if title != nil {
+		log.Printf("Pruned %s (%s) from the file cache: %s", *title, id, reason)
+		result.pruned++
+	}
+}
+
+// Gets the text content of a file from the source and saves it to the cache
+func (s *FileService) refreshFileCache(ctx context.Context, file *model.File) error {
+	text, err := s.getFileText(ctx, file)
+	if err != nil {
+		return err
+	}
+
+	return s.saveFileCache(ctx, file, text)
+}
+
+// Gets the plain text content of a file, for searching
+func (s *FileService) getFileText(ctx context.Context, file *model.File) (*string, error) {
+	switch file.MimeType {
+	case pdfMimeType:
+		contents, err := s.downloadFileContent(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+
+		text, err := extractPDFText(contents)
+		if err != nil {
+			return nil, err
+		}
+
+		return &text, nil
+	case spreadsheetMimeType:
+		// Spreadsheets are exported as XLSX, since the CSV export only includes the first sheet
+		contents, err := s.exportFileContent(ctx, file, xlsxMimeType)
+		if err != nil {
+			return nil, err
+		}
+
+		text, err := extractSpreadsheetText(contents)
+		if err != nil {
+			return nil, err
+		}
+
+		return &text, nil
+	case presentationMimeType:
+		contents, err := s.exportFileContent(ctx, file, textMimeType)
+		if err != nil {
+			return nil, err
+		}
+
+		text := string(contents)
+		return &text, nil
+	}
+
+	contents, err := s.getFileContents(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	strippedContent := strip.StripTags(*contents)
+	strippedContent = strings.ReplaceAll(strippedContent, "&nbsp;", "")
+	strippedContent = html.UnescapeString(strippedContent)
+
+	return &strippedContent, nil
+}
+
+// Determines if the cached copy of a file needs to be refreshed, because the file is not cached, was modified after it was cached,
+// or has different metadata. Changes such as renaming or starring a file do not change its modified time.
+func isStale(file *model.File, cachedFile *model.File) bool {
+	if cachedFile == nil || isNewer(file.LastUpdated, cachedFile.LastUpdated) {
+		return true
+	}
+
+	return file.Name != cachedFile.Name ||
+		file.MimeType != cachedFile.MimeType ||
+		!equalOptionalSize(file.SizeBytes, cachedFile.SizeBytes) ||
+		!equalOptionalString(file.WebViewLink, cachedFile.WebViewLink) ||
+		!equalOptionalString(file.Description, cachedFile.Description) ||
+		!equalOptionalString(file.IconLink, cachedFile.IconLink) ||
+		file.Starred != cachedFile.Starred ||
+		!equalOwners(file.Owners, cachedFile.Owners)
+}
+
+// Determines if two optional strings are both missing or are equal
+func equalOptionalString(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// Determines if two optional sizes are both missing or are equal
+func equalOptionalSize(a *int64, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// Determines if two lists of owners have the same owners in the same order
+func equalOwners(a []*model.FileOwner, b []*model.FileOwner) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Name != b[i].Name || !equalOptionalString(a[i].Email, b[i].Email) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Determines if the timestamp a is after the timestamp b. A timestamp that cannot be parsed is treated as older than any other.
+func isNewer(a string, b string) bool {
+	t1, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
+	}
+
+	t2, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return true
+	}
+
+	return t1.After(t2)
+}
+
+// Gets a message that describes a sync error without the GraphQL location prefix
+func syncErrorMessage(err error) string {
+	if gqlErr, ok := err.(*gqlerror.Error); ok {
+		return gqlErr.Message
+	}
+
+	return err.Error()
+}
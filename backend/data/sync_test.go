@@ -0,0 +1,389 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/drivetest"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+func TestPlanChanges(t *testing.T) {
+	source := newMemorySource()
+	folder := &DriveFolderItem{ID: "protocols", Name: "Protocols", Type: folderMimeType, Parents: []*DriveParent{{ID: "root"}}}
+	archive := &DriveFolderItem{ID: "archive", Name: "Archive", Type: folderMimeType, Parents: []*DriveParent{{ID: "elsewhere"}}}
+	source.children["root"] = []*DriveFolderItem{folder}
+	source.children["elsewhere"] = []*DriveFolderItem{archive}
+
+	document := func(id string, parent string) *DriveFolderItem {
+		return &DriveFolderItem{ID: id, Name: id, Type: "application/vnd.google-apps.document", Parents: []*DriveParent{{ID: parent}}}
+	}
+	trashed := document("trashed", "protocols")
+	trashed.Labels.Trashed = true
+	renamed := document("renamed", "protocols")
+	renamed.Name = "Renamed"
+	alsoInFolder := document("also-in-folder", "root")
+	alsoInFolder.Parents = append(alsoInFolder.Parents, &DriveParent{ID: "protocols"})
+	root := &DriveFolderItem{ID: "root", Name: "SOPs", Type: folderMimeType}
+
+	changes := []*DriveChange{
+		{FileID: "edited", File: document("edited", "protocols")},
+		{FileID: "deleted", Deleted: true},
+		{FileID: "trashed", File: trashed},
+		{FileID: "moved-out", File: document("moved-out", "archive")},
+		{FileID: "unshared-parent", File: document("unshared-parent", "private")},
+		{FileID: "protocols", File: folder},
+		{FileID: "renamed", File: document("renamed", "protocols")},
+		{FileID: "renamed", File: renamed},
+		{FileID: "unfiled", File: document("unfiled", "root")},
+		{FileID: "also-in-folder", File: alsoInFolder},
+		{FileID: "root", File: root},
+	}
+
+	plan, syncErrors := (&FileService{Source: source}).planChanges(context.Background(), changes)
+	if len(syncErrors) != 0 {
+		t.Fatalf("unexpected errors: %v", syncErrors)
+	}
+
+	refreshed := []string{}
+	for _, file := range plan.refresh {
+		refreshed = append(refreshed, file.Name)
+	}
+
+	if !reflect.DeepEqual(refreshed, []string{"edited", "Renamed", "also-in-folder"}) {
+		t.Errorf("unexpected files to refresh: %v", refreshed)
+	}
+	removed := []string{}
+	for _, item := range plan.remove {
+		removed = append(removed, item.id+": "+item.reason)
+	}
+
+	expectedRemoved := []string{
+		"deleted: " + pruneReasonDeleted,
+		"trashed: " + pruneReasonTrashed,
+		"moved-out: " + pruneReasonMoved,
+		"unshared-parent: " + pruneReasonMoved,
+		"unfiled: " + pruneReasonUnfiled,
+	}
+	if !reflect.DeepEqual(removed, expectedRemoved) {
+		t.Errorf("unexpected files to remove: %v", removed)
+	}
+	if plan.fullSync {
+		t.Error("expected no full sync when only folders inside the root folder changed")
+	}
+	if !reflect.DeepEqual(plan.folders, []string{"protocols"}) {
+		t.Errorf("unexpected folders to walk: %v", plan.folders)
+	}
+}
+
+func TestPlanChangesWalksEverythingWhenAFolderLeavesTheRoot(t *testing.T) {
+	source := newMemorySource()
+	movedOut := &DriveFolderItem{ID: "old-protocols", Name: "Old protocols", Type: folderMimeType, Parents: []*DriveParent{{ID: "personal"}}}
+
+	plan, syncErrors := (&FileService{Source: source}).planChanges(context.Background(), []*DriveChange{{FileID: movedOut.ID, File: movedOut}})
+	if len(syncErrors) != 0 {
+		t.Fatalf("unexpected errors: %v", syncErrors)
+	}
+
+	if !plan.fullSync {
+		t.Error("expected a full sync, since the cache does not know which documents were in the folder")
+	}
+	if len(plan.remove) != 0 || len(plan.folders) != 0 {
+		t.Errorf("expected nothing else to be planned, got %#v", plan)
+	}
+}
+
+func TestRetryChanges(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddDocument("protocols", "pcr", "PCR", "2023-01-01T00:00:00.000Z")
+	drive.AddDocument("protocols", "restricted", "Restricted", "2023-01-01T00:00:00.000Z")
+	drive.Forbid("restricted")
+	result := &syncResult{errors: []error{}, failed: []*failedFile{}}
+
+	changes := newTestFileService(drive).retryChanges(context.Background(), []string{"pcr", "deleted", "restricted"}, result)
+	if len(changes) != 2 || changes[0].File == nil || changes[0].File.ID != "pcr" || !changes[1].Deleted || changes[1].FileID != "deleted" {
+		t.Errorf("expected a change for PCR and a deletion, got %#v", changes)
+	}
+	if len(result.errors) != 0 || !reflect.DeepEqual(result.failedIds(), []string{"restricted"}) {
+		t.Errorf("expected the restricted document to be retried again without holding back the sync, got %v and %v", result.errors, result.failedIds())
+	}
+}
+
+// Adds a PDF that cannot be read, so it can never be refreshed
+func addCorruptPDF(drive *drivetest.Server, parent string, id string) {
+	drive.AddFile(parent, &drivetest.File{ID: id, Title: id, MimeType: drivetest.PDFMimeType, ModifiedDate: "2023-01-01T00:00:00.000Z"})
+	drive.SetMedia(id, []byte("not a PDF"))
+}
+
+func TestSyncAdvancesPastDocumentsThatFail(t *testing.T) {
+	newTestDB(t)
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddDocument("protocols", "pcr", "PCR", "2023-01-01T00:00:00.000Z")
+	drive.SetExport("pcr", drivetest.HTMLMimeType, []byte("<p>Anneal at 55 C</p>"))
+	addCorruptPDF(drive, "protocols", "manual")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	status := service.SyncFiles(ctx)
+	if status.DocumentsUpdated != 1 || len(status.Errors) != 1 {
+		t.Fatalf("expected PCR to be cached and the PDF to fail, got %#v", status)
+	}
+
+	// The page token is saved even though the PDF failed, and the PDF is saved to be retried
+	pageToken, err := service.getSyncValue(ctx, changesPageTokenKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pageToken == "" {
+		t.Error("expected the page token to be saved")
+	}
+	retryIds, err := service.getSyncValue(ctx, retryFilesKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retryIds != `["manual"]` {
+		t.Errorf("expected the PDF to be retried, got %s", retryIds)
+	}
+
+	// The next sync has no changes to list, but retries the PDF
+	drive.SetMedia("manual", newTestPDF("Autoclave at 121 C"))
+	status = service.SyncFiles(ctx)
+	if status.DocumentsUpdated != 1 || len(status.Errors) != 0 {
+		t.Fatalf("expected the PDF to be cached when it is retried, got %#v", status)
+	}
+	retryIds, err = service.getSyncValue(ctx, retryFilesKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retryIds != "[]" {
+		t.Errorf("expected nothing left to retry, got %s", retryIds)
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	size := int64(52431)
+	otherSize := int64(52432)
+	link := "https://drive.google.com/file/d/manual/view"
+	description := "From the manufacturer"
+	email := "manager@example.edu"
+	cached := func() *model.File {
+		return &model.File{
+			ID:          "manual",
+			Name:        "Autoclave manual",
+			LastUpdated: "2023-01-01T00:00:00.000Z",
+			MimeType:    pdfMimeType,
+			SizeBytes:   &size,
+			Owners:      []*model.FileOwner{{Name: "Lab Manager", Email: &email}},
+			WebViewLink: &link,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		change   func(file *model.File)
+		expected bool
+	}{
+		{"unchanged", func(file *model.File) {}, false},
+		{"older timestamp", func(file *model.File) { file.LastUpdated = "2022-01-01T00:00:00.000Z" }, false},
+		{"modified", func(file *model.File) { file.LastUpdated = "2023-02-01T00:00:00.000Z" }, true},
+		{"renamed", func(file *model.File) { file.Name = "Autoclave" }, true},
+		{"starred", func(file *model.File) { file.Starred = true }, true},
+		{"described", func(file *model.File) { file.Description = &description }, true},
+		{"resized", func(file *model.File) { file.SizeBytes = &otherSize }, true},
+		{"size removed", func(file *model.File) { file.SizeBytes = nil }, true},
+		{"icon added", func(file *model.File) { file.IconLink = &link }, true},
+		{"link removed", func(file *model.File) { file.WebViewLink = nil }, true},
+		{"owner added", func(file *model.File) { file.Owners = append(file.Owners, &model.FileOwner{Name: "Hidden"}) }, true},
+		{"owner email hidden", func(file *model.File) { file.Owners = []*model.FileOwner{{Name: "Lab Manager"}} }, true},
+	}
+
+	for _, test := range tests {
+		file := cached()
+		test.change(file)
+		if stale := isStale(file, cached()); stale != test.expected {
+			t.Errorf("%s: expected stale to be %v, got %v", test.name, test.expected, stale)
+		}
+	}
+
+	if !isStale(cached(), nil) {
+		t.Error("expected a file that is not cached to be stale")
+	}
+}
+
+func TestPruneLogRecordsEachReason(t *testing.T) {
+	newTestDB(t)
+	service := &FileService{}
+	ctx := context.Background()
+	cacheTestFiles(t, service,
+		&model.File{ID: "deleted", Name: "Deleted"},
+		&model.File{ID: "trashed", Name: "Trashed"},
+		&model.File{ID: "moved", Name: "Moved"},
+		&model.File{ID: "unfiled", Name: "Unfiled"},
+		&model.File{ID: "missing", Name: "Missing"},
+		&model.File{ID: "kept", Name: "Kept"},
+	)
+
+	result := &syncResult{}
+	service.pruneFile(ctx, "deleted", pruneReasonDeleted, result)
+	service.pruneFile(ctx, "trashed", pruneReasonTrashed, result)
+	service.pruneFile(ctx, "moved", pruneReasonMoved, result)
+	service.pruneFile(ctx, "unfiled", pruneReasonUnfiled, result)
+	service.pruneMissingFiles(ctx, []*model.File{{ID: "kept"}}, result)
+
+	// A file that was never cached has nothing to prune
+	service.pruneFile(ctx, "never-cached", pruneReasonDeleted, result)
+
+	if len(result.errors) != 0 || result.pruned != 5 {
+		t.Fatalf("expected 5 files to be pruned, got %d with errors %v", result.pruned, result.errors)
+	}
+
+	history, err := service.GetPruneHistory(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The most recently pruned file comes first
+	entries := []string{}
+	for _, prunedFile := range history {
+		entries = append(entries, prunedFile.ID+" "+prunedFile.Name+": "+prunedFile.Reason)
+	}
+	expected := []string{
+		"missing Missing: " + pruneReasonMissing,
+		"unfiled Unfiled: " + pruneReasonUnfiled,
+		"moved Moved: " + pruneReasonMoved,
+		"trashed Trashed: " + pruneReasonTrashed,
+		"deleted Deleted: " + pruneReasonDeleted,
+	}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Errorf("expected one entry for each pruned file:\n%v\ngot:\n%v", expected, entries)
+	}
+
+	cachedFiles, err := service.getCachedFiles(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cachedFiles) != 1 || cachedFiles["kept"] == nil {
+		t.Errorf("expected only Kept to be left in the cache, got %v", cachedFiles)
+	}
+
+	history, err = service.GetPruneHistory(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].ID != "missing" || history[1].ID != "unfiled" {
+		t.Errorf("expected only the 2 most recently pruned files, got %#v", history)
+	}
+}
+
+func TestNewSyncStatus(t *testing.T) {
+	result := &syncResult{updated: 3, pruned: 1}
+	result.addError(&FolderError{FolderID: "protocols", Err: errors.New("quota exceeded")})
+	result.addFailedFile("manual", errors.New("could not update Manual (manual): not a PDF"))
+
+	status := newSyncStatus("2023-01-01T00:00:00Z", 1500*time.Millisecond, result)
+
+	if status.Running || status.LastRun == nil || *status.LastRun != "2023-01-01T00:00:00Z" || status.DurationMs == nil || *status.DurationMs != 1500 {
+		t.Errorf("expected a finished sync with its start and duration, got %#v", status)
+	}
+	if status.DocumentsUpdated != 3 || status.DocumentsPruned != 1 {
+		t.Errorf("expected the counts of the sync, got %d updated and %d pruned", status.DocumentsUpdated, status.DocumentsPruned)
+	}
+	expected := []string{"folder protocols: quota exceeded", "could not update Manual (manual): not a PDF"}
+	if !reflect.DeepEqual(status.Errors, expected) {
+		t.Errorf("expected the folder error and the failed document, got %v", status.Errors)
+	}
+}
+
+func TestSyncStatusAfterFailedSync(t *testing.T) {
+	source := newMemorySource()
+	source.failing["root"] = true
+	service := &FileService{Source: source}
+	ctx := context.Background()
+
+	status, err := service.GetSyncStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.LastRun != nil || status.DurationMs != nil || status.Errors == nil || len(status.Errors) != 0 {
+		t.Errorf("expected an empty status before the first sync, got %#v", status)
+	}
+
+	before := time.Now().UTC().Truncate(time.Second)
+	service.SyncFiles(ctx)
+
+	status, err = service.GetSyncStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Running || status.DurationMs == nil || status.DocumentsUpdated != 0 || status.DocumentsPruned != 0 {
+		t.Errorf("expected a finished sync that changed nothing, got %#v", status)
+	}
+	if status.LastRun == nil {
+		t.Fatal("expected the time of the sync")
+	}
+	if lastRun, err := time.Parse(time.RFC3339, *status.LastRun); err != nil || lastRun.Before(before) {
+		t.Errorf("expected the time the sync started, got %s", *status.LastRun)
+	}
+	if len(status.Errors) != 1 || !strings.Contains(status.Errors[0], "could not list the root folder") {
+		t.Errorf("expected the root folder error, got %v", status.Errors)
+	}
+
+	// The returned status is a copy, so callers cannot change the saved errors
+	status.Errors[0] = "changed"
+	if status, _ := service.GetSyncStatus(ctx); status.Errors[0] == "changed" {
+		t.Error("expected the saved status not to change")
+	}
+}
+
+func TestSyncStatusAfterSuccessfulSync(t *testing.T) {
+	newTestDB(t)
+	root := filepath.Join(t.TempDir(), "sops")
+	service := &FileService{Source: &LocalSource{Root: root}}
+	ctx := context.Background()
+
+	// The root folder does not exist yet, so the first sync fails
+	service.SyncFiles(ctx)
+	status, err := service.GetSyncStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status.Errors) != 1 || status.DocumentsUpdated != 0 {
+		t.Fatalf("expected the first sync to fail, got %#v", status)
+	}
+
+	writeTestFile(t, filepath.Join(root, "Buffers", "PBS.md"), []byte("# PBS\n\n1. Add salt\n"))
+	writeTestFile(t, filepath.Join(root, "Buffers", "Tris.md"), []byte("# Tris\n\n1. Add water\n"))
+	service.SyncFiles(ctx)
+
+	status, err = service.GetSyncStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status.Errors) != 0 || status.DocumentsUpdated != 2 || status.DocumentsPruned != 0 {
+		t.Errorf("expected both documents to be cached and the old errors to be cleared, got %#v", status)
+	}
+	if status.Running || status.LastRun == nil || status.DurationMs == nil {
+		t.Errorf("expected the time and duration of the sync, got %#v", status)
+	}
+
+	if err := os.Remove(filepath.Join(root, "Buffers", "Tris.md")); err != nil {
+		t.Fatal(err)
+	}
+	service.SyncFiles(ctx)
+
+	status, err = service.GetSyncStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status.Errors) != 0 || status.DocumentsUpdated != 0 || status.DocumentsPruned != 1 {
+		t.Errorf("expected only the removed document to be pruned, got %#v", status)
+	}
+}
@@ -0,0 +1,157 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// The number of folders that are listed at the same time when FileService.TraversalWorkers is not set
+const defaultTraversalWorkers = 8
+
+// An error that occurred while listing a single folder during a traversal
+type FolderError struct {
+	FolderID string
+	Err      error
+}
+
+func (e *FolderError) Error() string {
+	return fmt.Sprintf("folder %s: %s", e.FolderID, e.Err)
+}
+
+func (e *FolderError) Unwrap() error {
+	return e.Err
+}
+
+// A folder visited during a traversal
+type traversalNode struct {
+	id       string
+	contents []model.FolderItem
+	children map[string]*traversalNode
+	err      error
+}
+
+// Gets all files in the given folders, including files in nested folders. Folders are listed concurrently, but files are
+// always returned in the same order: the files in each folder sorted by name, with the files in nested folders in place of the folder.
+// Files that are in more than one folder are only returned the first time. Folders that could not be listed are skipped and
+// returned as FolderErrors. An error is only returned if the context is cancelled.
+func (s *FileService) traverseFolders(ctx context.Context, folderIds []string) ([]*model.File, []*FolderError, error) {
+	roots, err := s.walkFolders(ctx, folderIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files, folderErrors := collectFiles(roots)
+	return files, folderErrors, nil
+}
+
+// Gets all files found by walkFolders, in the order described by traverseFolders, along with the folders that could not be listed
+func collectFiles(roots []*traversalNode) ([]*model.File, []*FolderError) {
+	files := []*model.File{}
+	seen := map[string]bool{}
+	folderErrors := []*FolderError{}
+
+	var collect func(node *traversalNode)
+	collect = func(node *traversalNode) {
+		if node.err != nil {
+			folderErrors = append(folderErrors, &FolderError{FolderID: node.id, Err: node.err})
+			return
+		}
+
+		for _, item := range node.contents {
+			if file, ok := item.(*model.File); ok {
+				if !seen[file.ID] {
+					seen[file.ID] = true
+					files = append(files, file)
+				}
+			} else if folder, ok := item.(*model.Folder); ok {
+				collect(node.children[folder.ID])
+			}
+		}
+	}
+
+	for _, root := range roots {
+		collect(root)
+	}
+
+	return files, folderErrors
+}
+
+// Lists the given folders and every folder nested inside them, using up to TraversalWorkers goroutines. Returns a node for each
+// of the given folders, with the contents and nested folders of each folder that could be listed. An error is only returned if
+// the context is cancelled.
+func (s *FileService) walkFolders(ctx context.Context, folderIds []string) ([]*traversalNode, error) {
+	workers := s.TraversalWorkers
+	if workers <= 0 {
+		workers = defaultTraversalWorkers
+	}
+
+	semaphore := make(chan struct{}, workers)
+	wg := &sync.WaitGroup{}
+
+	var visit func(node *traversalNode)
+	visit = func(node *traversalNode) {
+		defer wg.Done()
+
+		// Wait for a free worker, unless the traversal has been cancelled
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			node.err = ctx.Err()
+			return
+		}
+
+		items, err := s.Source.ListFolder(ctx, node.id)
+		<-semaphore
+		if err != nil {
+			node.err = err
+			return
+		}
+
+		// Only this goroutine writes to the node, and the children are not read until every goroutine has finished
+		node.contents = s.newFolderItems(items)
+		node.children = map[string]*traversalNode{}
+		for _, item := range node.contents {
+			if folder, ok := item.(*model.Folder); ok {
+				child := &traversalNode{id: folder.ID}
+				node.children[folder.ID] = child
+
+				wg.Add(1)
+				go visit(child)
+			}
+		}
+	}
+
+	roots := []*traversalNode{}
+	for _, id := range folderIds {
+		root := &traversalNode{id: id}
+		roots = append(roots, root)
+
+		wg.Add(1)
+		go visit(root)
+	}
+
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	return roots, nil
+}
+
+// Logs folders that could not be listed during a traversal. When called while resolving a GraphQL request, the errors are also
+// added to the response so clients know that the results are incomplete.
+func (s *FileService) reportFolderErrors(ctx context.Context, folderErrors []*FolderError) {
+	for _, folderError := range folderErrors {
+		err := errors.NewInternalError(ctx, fmt.Sprintf("Some files are missing because the folder %s could not be read.", folderError.FolderID), folderError)
+
+		if graphql.HasOperationContext(ctx) {
+			graphql.AddError(ctx, err)
+		}
+	}
+}
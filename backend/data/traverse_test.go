@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// An in-memory DocumentSource that records how many folders are listed at the same time
+type memorySource struct {
+	children map[string][]*DriveFolderItem
+	failing  map[string]bool
+	delay    time.Duration
+
+	mutex       sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func newMemorySource() *memorySource {
+	return &memorySource{children: map[string][]*DriveFolderItem{}, failing: map[string]bool{}}
+}
+
+func (m *memorySource) RootFolderID() string {
+	return "root"
+}
+
+func (m *memorySource) ListFolder(ctx context.Context, folderId string) ([]*DriveFolderItem, error) {
+	m.mutex.Lock()
+	m.inFlight++
+	if m.inFlight > m.maxInFlight {
+		m.maxInFlight = m.inFlight
+	}
+	m.mutex.Unlock()
+
+	defer func() {
+		m.mutex.Lock()
+		m.inFlight--
+		m.mutex.Unlock()
+	}()
+
+	select {
+	case <-time.After(m.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if m.failing[folderId] {
+		return nil, fmt.Errorf("quota exceeded")
+	}
+
+	// Return a copy, since the FileService sorts the items in place
+	return append([]*DriveFolderItem{}, m.children[folderId]...), nil
+}
+
+func (m *memorySource) GetItem(ctx context.Context, id string) (*DriveFolderItem, error) {
+	for _, items := range m.children {
+		for _, item := range items {
+			if item.ID == id {
+				return item, nil
+			}
+		}
+	}
+	return nil, ErrItemNotFound
+}
+
+func (m *memorySource) ExportContent(ctx context.Context, id string, mimeType string) ([]byte, error) {
+	return []byte("<p>" + id + "</p>"), nil
+}
+
+func (m *memorySource) DownloadContent(ctx context.Context, id string) ([]byte, error) {
+	return []byte(id), nil
+}
+
+// Builds a tree of folders that are depth levels deep, with width subfolders and one document in every folder
+func (m *memorySource) addTree(parent string, depth int, width int) {
+	m.children[parent] = append(m.children[parent], &DriveFolderItem{ID: parent + "/doc", Name: "doc", Type: "application/vnd.google-apps.document"})
+	if depth == 0 {
+		return
+	}
+
+	for i := 0; i < width; i++ {
+		id := fmt.Sprintf("%s/%d", parent, i)
+		m.children[parent] = append(m.children[parent], &DriveFolderItem{ID: id, Name: fmt.Sprintf("folder %d", i), Type: folderMimeType})
+		m.addTree(id, depth-1, width)
+	}
+}
+
+func fileIDs(service *FileService, t *testing.T) []string {
+	files, folderErrors, err := service.traverseFolders(context.Background(), []string{"root"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folderErrors) != 0 {
+		t.Fatalf("unexpected folder errors: %v", folderErrors)
+	}
+
+	ids := []string{}
+	for _, file := range files {
+		ids = append(ids, file.ID)
+	}
+	return ids
+}
+
+func TestTraverseFoldersIsDeterministic(t *testing.T) {
+	source := newMemorySource()
+	source.addTree("root", 3, 3)
+
+	sequential := fileIDs(&FileService{Source: source, TraversalWorkers: 1}, t)
+	concurrent := fileIDs(&FileService{Source: source, TraversalWorkers: 16}, t)
+
+	if len(sequential) != 40 {
+		t.Fatalf("expected 40 files, got %d", len(sequential))
+	}
+	if !reflect.DeepEqual(sequential, concurrent) {
+		t.Errorf("expected the same order regardless of worker count\nsequential: %v\nconcurrent: %v", sequential, concurrent)
+	}
+	if sequential[0] != "root/doc" || sequential[1] != "root/0/doc" || sequential[2] != "root/0/0/doc" {
+		t.Errorf("expected depth-first order, got %v", sequential[:3])
+	}
+}
+
+func TestTraverseFoldersLimitsWorkers(t *testing.T) {
+	source := newMemorySource()
+	source.addTree("root", 2, 8)
+	source.delay = 5 * time.Millisecond
+
+	fileIDs(&FileService{Source: source, TraversalWorkers: 3}, t)
+
+	if source.maxInFlight > 3 {
+		t.Errorf("expected at most 3 concurrent requests, got %d", source.maxInFlight)
+	}
+	if source.maxInFlight < 2 {
+		t.Errorf("expected folders to be listed concurrently, got %d at a time", source.maxInFlight)
+	}
+}
+
+func TestTraverseFoldersReportsPartialFailures(t *testing.T) {
+	source := newMemorySource()
+	source.addTree("root", 2, 2)
+	source.failing["root/1"] = true
+
+	files, folderErrors, err := (&FileService{Source: source}).traverseFolders(context.Background(), []string{"root"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(folderErrors) != 1 || folderErrors[0].FolderID != "root/1" {
+		t.Fatalf("expected a single error for root/1, got %v", folderErrors)
+	}
+	if len(files) != 4 {
+		t.Errorf("expected the 4 files outside root/1, got %d", len(files))
+	}
+}
+
+func TestTraverseFoldersStopsWhenCancelled(t *testing.T) {
+	source := newMemorySource()
+	source.addTree("root", 3, 4)
+	source.delay = 50 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := (&FileService{Source: source, TraversalWorkers: 2}).traverseFolders(ctx, []string{"root"})
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("expected the traversal to stop promptly, took %s", time.Since(start))
+	}
+}
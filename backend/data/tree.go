@@ -0,0 +1,470 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+)
+
+// The contents of every folder in the root folder at one point in time
+type folderTreeSnapshot struct {
+	rootId string
+
+	// The contents of each folder, keyed by folder ID. The root folder only contains folders, like the folders query.
+	contents map[string][]model.FolderItem
+}
+
+// Keeps the most recent complete folder tree snapshot, so the tree can be returned without listing every folder
+type folderTreeCache struct {
+	mutex    sync.RWMutex
+	snapshot *folderTreeSnapshot
+
+	// Counts every time the cached tree was set or changed, including changes that were dropped because there was no tree, so a
+	// tree that was built while the cache changed is not stored over a newer one
+	generation uint64
+}
+
+func (c *folderTreeCache) get() *folderTreeSnapshot {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.snapshot
+}
+
+func (c *folderTreeCache) set(snapshot *folderTreeSnapshot) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.snapshot = snapshot
+	c.generation++
+}
+
+// Gets the tree along with its generation, which is passed to setIfUnchanged after building a new tree
+func (c *folderTreeCache) getWithGeneration() (*folderTreeSnapshot, uint64) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.snapshot, c.generation
+}
+
+// Stores a tree, unless the cache has been set or changed since the given generation, such as by a sync that finished while the
+// tree was being built. Returns false if the tree was not stored.
+func (c *folderTreeCache) setIfUnchanged(snapshot *folderTreeSnapshot, generation uint64) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.generation != generation {
+		return false
+	}
+
+	c.snapshot = snapshot
+	c.generation++
+	return true
+}
+
+// Applies a change to a copy of the cached tree, since snapshots are read without holding the lock. When the change cannot be
+// applied, the cached tree is dropped so it is rebuilt the next time it is needed.
+func (c *folderTreeCache) update(change func(snapshot *folderTreeSnapshot) bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.generation++
+	if c.snapshot == nil {
+		return
+	}
+
+	updated := &folderTreeSnapshot{rootId: c.snapshot.rootId, contents: make(map[string][]model.FolderItem, len(c.snapshot.contents)+1)}
+	for id, items := range c.snapshot.contents {
+		updated.contents[id] = items
+	}
+
+	if change(updated) {
+		c.snapshot = updated
+	} else {
+		c.snapshot = nil
+	}
+}
+
+// Adds an item to a folder in the cached tree
+func (c *folderTreeCache) addItem(folderId string, item model.FolderItem) {
+	c.update(func(snapshot *folderTreeSnapshot) bool {
+		return snapshot.add(folderId, item)
+	})
+}
+
+// Replaces an item in the cached tree with a changed copy of it, such as after it was renamed
+func (c *folderTreeCache) replaceItem(item model.FolderItem) {
+	c.update(func(snapshot *folderTreeSnapshot) bool {
+		id, _ := folderItemInfo(item)
+
+		parentId, ok := snapshot.parentOf(id)
+		if !ok {
+			return isFileItem(item)
+		}
+
+		snapshot.contents[parentId] = sortFolderItems(append(withoutFolderItem(snapshot.contents[parentId], id), item))
+		return true
+	})
+}
+
+// Moves an item into another folder in the cached tree, keeping the contents of a moved folder
+func (c *folderTreeCache) moveItem(folderId string, item model.FolderItem) {
+	c.update(func(snapshot *folderTreeSnapshot) bool {
+		id, _ := folderItemInfo(item)
+
+		if parentId, ok := snapshot.parentOf(id); ok {
+			snapshot.contents[parentId] = withoutFolderItem(snapshot.contents[parentId], id)
+		} else if !isFileItem(item) {
+			return false
+		}
+
+		if _, isFolder := item.(*model.Folder); isFolder {
+			if _, ok := snapshot.contents[id]; !ok {
+				return false
+			}
+		} else if folderId == snapshot.rootId {
+			return true
+		}
+
+		items, ok := snapshot.contents[folderId]
+		if !ok {
+			return false
+		}
+
+		snapshot.contents[folderId] = sortFolderItems(append(append([]model.FolderItem{}, items...), item))
+		return true
+	})
+}
+
+// Removes an item from the cached tree, along with everything inside it
+func (c *folderTreeCache) removeItem(id string) {
+	c.update(func(snapshot *folderTreeSnapshot) bool {
+		snapshot.detach(id)
+		snapshot.removeContents(id)
+		return true
+	})
+}
+
+// Applies the changes found by an incremental sync to the cached tree. Changed folders get the contents found by walking them, so
+// every folder in the plan needs to have been walked. When a change cannot be applied, such as when a folder could not be listed
+// or an item's folder is not in the tree, the cached tree is dropped so it is rebuilt the next time it is needed.
+func (c *folderTreeCache) applyChanges(plan *changePlan, walked []*traversalNode) {
+	c.update(func(snapshot *folderTreeSnapshot) bool {
+		for _, item := range plan.remove {
+			snapshot.detach(item.id)
+			snapshot.removeContents(item.id)
+		}
+
+		nodes := map[string]*traversalNode{}
+		for _, node := range walked {
+			nodes[node.id] = node
+		}
+
+		// An item can only be placed once one of its folders is in the tree, which may be a folder that changed too
+		pending := plan.changed
+		for len(pending) > 0 {
+			remaining := []*changedItem{}
+			for _, changed := range pending {
+				if !snapshot.hasAnyFolder(changed.parentIds) {
+					remaining = append(remaining, changed)
+				} else if !snapshot.place(changed, nodes) {
+					return false
+				}
+			}
+
+			if len(remaining) == len(pending) {
+				return false
+			}
+			pending = remaining
+		}
+
+		return true
+	})
+}
+
+// Adds an item to a folder in the snapshot. Files are never added to the root folder, since it only holds folders. Returns false
+// if the folder is not in the snapshot.
+func (t *folderTreeSnapshot) add(folderId string, item model.FolderItem) bool {
+	if _, isFolder := item.(*model.Folder); !isFolder && folderId == t.rootId {
+		return true
+	}
+
+	items, ok := t.contents[folderId]
+	if !ok {
+		return false
+	}
+
+	t.contents[folderId] = sortFolderItems(append(append([]model.FolderItem{}, items...), item))
+	if folder, ok := item.(*model.Folder); ok {
+		t.contents[folder.ID] = []model.FolderItem{}
+	}
+
+	return true
+}
+
+// Puts a changed item into each of its folders that are in the snapshot, taking it out of the folders it was in before. The contents
+// of a changed folder are replaced by the contents found by walking it. Returns false if the folder was not walked completely.
+func (t *folderTreeSnapshot) place(changed *changedItem, nodes map[string]*traversalNode) bool {
+	id, _ := folderItemInfo(changed.item)
+	t.detach(id)
+
+	if _, isFolder := changed.item.(*model.Folder); isFolder {
+		t.removeContents(id)
+		if !t.addWalk(nodes[id]) {
+			return false
+		}
+	}
+
+	for _, parentId := range changed.parentIds {
+		if _, isFolder := changed.item.(*model.Folder); !isFolder && parentId == t.rootId {
+			continue
+		}
+
+		if items, ok := t.contents[parentId]; ok {
+			t.contents[parentId] = sortFolderItems(append(append([]model.FolderItem{}, items...), changed.item))
+		}
+	}
+
+	return true
+}
+
+// Adds the contents of a walked folder and every folder inside it to the snapshot. Returns false if any of them could not be listed.
+func (t *folderTreeSnapshot) addWalk(node *traversalNode) bool {
+	if node == nil || node.err != nil {
+		return false
+	}
+
+	t.contents[node.id] = node.contents
+	for _, item := range node.contents {
+		if folder, ok := item.(*model.Folder); ok && !t.addWalk(node.children[folder.ID]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Takes an item out of every folder in the snapshot that holds it
+func (t *folderTreeSnapshot) detach(id string) {
+	for parentId, items := range t.contents {
+		for _, item := range items {
+			if itemId, _ := folderItemInfo(item); itemId == id {
+				t.contents[parentId] = withoutFolderItem(items, id)
+				break
+			}
+		}
+	}
+}
+
+// Removes the contents of a folder from the snapshot, along with the contents of every folder inside it
+func (t *folderTreeSnapshot) removeContents(id string) {
+	for _, item := range t.contents[id] {
+		if folder, ok := item.(*model.Folder); ok {
+			t.removeContents(folder.ID)
+		}
+	}
+	delete(t.contents, id)
+}
+
+// Determines if any of the given folders are in the snapshot
+func (t *folderTreeSnapshot) hasAnyFolder(folderIds []string) bool {
+	for _, id := range folderIds {
+		if _, ok := t.contents[id]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Gets the ID of the folder in the snapshot that holds an item
+func (t *folderTreeSnapshot) parentOf(id string) (string, bool) {
+	for parentId, items := range t.contents {
+		for _, item := range items {
+			if itemId, _ := folderItemInfo(item); itemId == id {
+				return parentId, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// Determines if an item is a file. Files directly inside the root folder are not in the tree, so a file that is not found is not
+// treated as a missing item.
+func isFileItem(item model.FolderItem) bool {
+	_, isFile := item.(*model.File)
+	return isFile
+}
+
+// Gets a copy of a list of items without the item with the given ID
+func withoutFolderItem(items []model.FolderItem, id string) []model.FolderItem {
+	remaining := []model.FolderItem{}
+	for _, item := range items {
+		if itemId, _ := folderItemInfo(item); itemId != id {
+			remaining = append(remaining, item)
+		}
+	}
+
+	return remaining
+}
+
+// Sorts a list of items by name, like newFolderItems
+func sortFolderItems(items []model.FolderItem) []model.FolderItem {
+	sort.SliceStable(items, func(i, j int) bool {
+		return folderItemName(items[i]) < folderItemName(items[j])
+	})
+
+	return items
+}
+
+// Gets the ID and name of a folder or file
+func folderItemInfo(item model.FolderItem) (string, string) {
+	switch item := item.(type) {
+	case *model.Folder:
+		return item.ID, item.Name
+	case *model.File:
+		return item.ID, item.Name
+	}
+
+	return "", ""
+}
+
+// Gets the name of a folder or file
+func folderItemName(item model.FolderItem) string {
+	_, name := folderItemInfo(item)
+	return name
+}
+
+// Lists every folder in the root folder to build a folder tree snapshot. Folders that could not be listed are left out of the
+// snapshot and returned as FolderErrors.
+func (s *FileService) buildFolderTree(ctx context.Context) (*folderTreeSnapshot, []*FolderError, error) {
+	rootId := s.Source.RootFolderID()
+
+	rootItems, err := s.Source.ListFolder(ctx, rootId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not list the root folder: %w", err)
+	}
+
+	rootFolders := []model.FolderItem{}
+	folderIds := []string{}
+	for _, item := range s.newFolderItems(rootItems) {
+		if folder, ok := item.(*model.Folder); ok {
+			rootFolders = append(rootFolders, folder)
+			folderIds = append(folderIds, folder.ID)
+		}
+	}
+
+	nodes, err := s.walkFolders(ctx, folderIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshot := &folderTreeSnapshot{rootId: rootId, contents: map[string][]model.FolderItem{rootId: rootFolders}}
+	folderErrors := []*FolderError{}
+
+	var add func(node *traversalNode)
+	add = func(node *traversalNode) {
+		if node.err != nil {
+			folderErrors = append(folderErrors, &FolderError{FolderID: node.id, Err: node.err})
+			return
+		}
+
+		snapshot.contents[node.id] = node.contents
+		for _, item := range node.contents {
+			if folder, ok := item.(*model.Folder); ok {
+				add(node.children[folder.ID])
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		add(node)
+	}
+
+	return snapshot, folderErrors, nil
+}
+
+// Gets every file in the snapshot, in the same order as traverseFolders
+func (t *folderTreeSnapshot) files() []*model.File {
+	files := []*model.File{}
+	seen := map[string]bool{}
+
+	var collect func(id string)
+	collect = func(id string) {
+		for _, item := range t.contents[id] {
+			if file, ok := item.(*model.File); ok {
+				if !seen[file.ID] {
+					seen[file.ID] = true
+					files = append(files, file)
+				}
+			} else if folder, ok := item.(*model.Folder); ok {
+				collect(folder.ID)
+			}
+		}
+	}
+	collect(t.rootId)
+
+	return files
+}
+
+// Gets the items inside a folder in depth-first order, so each folder is followed by its contents. Items nested more than maxDepth
+// levels below the folder are left out, unless maxDepth is 0. Returns false if the folder is not in the snapshot.
+func (t *folderTreeSnapshot) nodes(folderId string, maxDepth int) ([]*model.FolderTreeNode, bool) {
+	if _, ok := t.contents[folderId]; !ok {
+		return nil, false
+	}
+
+	nodes := []*model.FolderTreeNode{}
+
+	var visit func(id string, depth int)
+	visit = func(id string, depth int) {
+		for _, item := range t.contents[id] {
+			node := &model.FolderTreeNode{ParentID: id, Depth: depth, Item: item}
+			node.ID, node.Name = folderItemInfo(item)
+			nodes = append(nodes, node)
+
+			if folder, ok := item.(*model.Folder); ok && (maxDepth == 0 || depth < maxDepth) {
+				visit(folder.ID, depth+1)
+			}
+		}
+	}
+	visit(folderId, 1)
+
+	return nodes, true
+}
+
+// Gets every item inside a folder, or inside the root folder when no folder ID is given, from the cached folder tree. Items more
+// than depth levels below the folder are left out, unless depth is 0. The tree is built if the sync job has not built it yet.
+func (s *FileService) GetFolderTree(ctx context.Context, rootId string, depth int) ([]*model.FolderTreeNode, error) {
+	snapshot, generation := s.folderTree.getWithGeneration()
+	if snapshot == nil {
+		built, folderErrors, err := s.buildFolderTree(ctx)
+		if err != nil {
+			return nil, sourceError(ctx, "An unexpected error occurred while retrieving the folder tree.", err)
+		}
+
+		// A partial tree is still returned, but it is not cached. Neither is a tree that may be older than one the sync stored or
+		// changed while it was being built.
+		s.reportFolderErrors(ctx, folderErrors)
+		if len(folderErrors) == 0 {
+			s.folderTree.setIfUnchanged(built, generation)
+		}
+		snapshot = built
+	}
+
+	if rootId == "" {
+		rootId = snapshot.rootId
+	}
+
+	nodes, ok := snapshot.nodes(rootId, depth)
+	if !ok {
+		return nil, errors.NewNotFoundError(ctx, "Oops! This folder does not exist.")
+	}
+
+	return nodes, nil
+}
@@ -0,0 +1,201 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestGetFolderTree(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddFolder("protocols", "buffers", "Buffers")
+	drive.AddDocument("buffers", "pbs", "PBS", "2023-01-01T00:00:00.000Z")
+	drive.AddDocument("protocols", "pcr", "PCR", "2023-01-01T00:00:00.000Z")
+	drive.AddDocument("root", "safety", "Safety", "2023-01-01T00:00:00.000Z")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	nodes, err := service.GetFolderTree(ctx, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		id       string
+		parentId string
+		depth    int
+	}{
+		{"protocols", "root", 1},
+		{"buffers", "protocols", 2},
+		{"pbs", "buffers", 3},
+		{"pcr", "protocols", 2},
+	}
+	if len(nodes) != len(expected) {
+		t.Fatalf("expected %d nodes, got %d", len(expected), len(nodes))
+	}
+	for i, node := range nodes {
+		if node.ID != expected[i].id || node.ParentID != expected[i].parentId || node.Depth != expected[i].depth {
+			t.Errorf("expected node %d to be %v, got %s in %s at depth %d", i, expected[i], node.ID, node.ParentID, node.Depth)
+		}
+	}
+
+	// The second request is served from the cached tree
+	requests := drive.Requests()
+	nodes, err = service.GetFolderTree(ctx, "protocols", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if drive.Requests() != requests {
+		t.Errorf("expected the cached tree to be used, but %d requests were made", drive.Requests()-requests)
+	}
+	if len(nodes) != 2 || nodes[0].ID != "buffers" || nodes[1].ID != "pcr" {
+		t.Errorf("expected only the items directly inside Protocols, got %#v", nodes)
+	}
+
+	_, err = service.GetFolderTree(ctx, "missing", 0)
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 404 {
+		t.Errorf("expected a not found error for an unknown folder, got %v", err)
+	}
+}
+
+func TestFolderTreeFilesMatchTraversal(t *testing.T) {
+	drive := newFakeDrive(t, 1)
+	drive.AddFolder("root", "protocols", "Protocols")
+	drive.AddFolder("protocols", "buffers", "Buffers")
+	drive.AddDocument("buffers", "pbs", "PBS", "2023-01-01T00:00:00.000Z")
+	drive.AddDocument("protocols", "pcr", "PCR", "2023-01-01T00:00:00.000Z")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	snapshot, folderErrors, err := service.buildFolderTree(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folderErrors) != 0 {
+		t.Fatalf("expected every folder to be listed, got %v", folderErrors)
+	}
+
+	files := snapshot.files()
+	if len(files) != 2 || files[0].ID != "pbs" || files[1].ID != "pcr" {
+		t.Errorf("expected PBS and PCR, got %#v", files)
+	}
+}
+
+func TestApplyChangesMatchesRebuiltTree(t *testing.T) {
+	drive, service := newEditTestDrive(t)
+	ctx := context.Background()
+
+	// Make the changes through another service, so the cached tree only sees them through the changes feed
+	editor := newTestFileService(drive)
+	reagents, err := editor.CreateFolder(ctx, "protocols", "Reagents")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stocks, err := editor.CreateFolder(ctx, reagents.ID, "Stocks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	drive.AddDocument(stocks.ID, "tris", "Tris", "2023-01-01T00:00:00.000Z")
+	if _, err := editor.renameItem(ctx, "pcr", "Amplification"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := editor.MoveItem(ctx, "pbs", "equipment"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := editor.MoveItem(ctx, "safety", "protocols"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := editor.trashItem(ctx, "buffers"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The nested folder comes first, so it can only be placed after the folder it is in
+	changes := service.retryChanges(ctx, []string{stocks.ID, "tris", reagents.ID, "pcr", "pbs", "safety", "buffers"}, &syncResult{})
+	plan, syncErrors := service.planChanges(ctx, changes)
+	if len(syncErrors) != 0 {
+		t.Fatalf("unexpected errors: %v", syncErrors)
+	}
+	walked, err := service.walkFolders(ctx, plan.folders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.folderTree.applyChanges(plan, walked)
+
+	if service.folderTree.get() == nil {
+		t.Fatal("expected the changes to be applied to the cached tree")
+	}
+	expected := formatTree(t, newTestFileService(drive))
+	if tree := formatTree(t, service); tree != expected {
+		t.Errorf("expected %s, got %s", expected, tree)
+	}
+}
+
+func TestApplyChangesDropsTreeWhenAChangeCannotBeApplied(t *testing.T) {
+	folder := &model.Folder{ID: "protocols", Name: "Protocols"}
+	file := &model.File{ID: "pcr", Name: "PCR"}
+
+	tests := []struct {
+		name   string
+		plan   *changePlan
+		walked []*traversalNode
+	}{
+		{
+			"folder that could not be listed",
+			&changePlan{folders: []string{"protocols"}, changed: []*changedItem{{item: folder, parentIds: []string{"root"}}}},
+			[]*traversalNode{{id: "protocols", err: errors.New("forbidden")}},
+		},
+		{
+			"folder that was not walked",
+			&changePlan{folders: []string{}, changed: []*changedItem{{item: folder, parentIds: []string{"root"}}}},
+			[]*traversalNode{},
+		},
+		{
+			"item in a folder that is not in the tree",
+			&changePlan{folders: []string{}, changed: []*changedItem{{item: file, parentIds: []string{"unknown"}}}},
+			[]*traversalNode{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cache := &folderTreeCache{}
+			cache.set(&folderTreeSnapshot{rootId: "root", contents: map[string][]model.FolderItem{"root": {}}})
+
+			cache.applyChanges(test.plan, test.walked)
+			if cache.get() != nil {
+				t.Error("expected the cached tree to be dropped")
+			}
+		})
+	}
+}
+
+func TestFolderTreeIsNotReplacedByAnOlderBuild(t *testing.T) {
+	newer := &folderTreeSnapshot{rootId: "root", contents: map[string][]model.FolderItem{"root": {&model.Folder{ID: "protocols"}}}}
+	older := &folderTreeSnapshot{rootId: "root", contents: map[string][]model.FolderItem{"root": {}}}
+
+	// The sync stores a tree while a request is building one
+	cache := &folderTreeCache{}
+	_, generation := cache.getWithGeneration()
+	cache.set(newer)
+	if cache.setIfUnchanged(older, generation) || cache.get() != newer {
+		t.Error("expected the tree stored by the sync to be kept")
+	}
+
+	// The sync applies a change while there is no tree, so a tree built from an earlier listing could be missing it
+	cache = &folderTreeCache{}
+	_, generation = cache.getWithGeneration()
+	cache.removeItem("protocols")
+	if cache.setIfUnchanged(older, generation) || cache.get() != nil {
+		t.Error("expected the tree not to be stored after a change was missed")
+	}
+
+	// Nothing changed while the tree was built
+	_, generation = cache.getWithGeneration()
+	if !cache.setIfUnchanged(newer, generation) || cache.get() != newer {
+		t.Error("expected the built tree to be stored")
+	}
+}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// The mime types of the files that can be uploaded, keyed by file extension
+var uploadFormats = map[string]string{
+	".docx": docxMimeType,
+	".pdf":  pdfMimeType,
+}
+
+// Uploads a DOCX or PDF file into a folder and adds it to the search cache right away, so it can be found before the next sync.
+// When convert is true, a DOCX file is converted to a Google Doc.
+func (s *FileService) UploadFile(ctx context.Context, folderId string, upload graphql.Upload, convert bool) (*model.File, error) {
+	file, err := s.uploadFile(ctx, folderId, upload, convert)
+	if err != nil {
+		return nil, err
+	}
+
+	// The file is already in the source, so the next sync adds it to the cache if this fails
+	if err := s.refreshFileCache(ctx, file); err != nil {
+		return nil, errors.NewInternalError(ctx, "The file was uploaded, but an unexpected error occurred while adding it to the search index.", err)
+	}
+
+	return file, nil
+}
+
+// Checks that an upload can be added to a folder, sends it to the source and adds it to the cached folder tree
+func (s *FileService) uploadFile(ctx context.Context, folderId string, upload graphql.Upload, convert bool) (*model.File, error) {
+	uploadSource, ok := s.Source.(UploadSource)
+	if !ok {
+		return nil, errors.NewInputError(ctx, "Files cannot be uploaded to this document source.")
+	}
+
+	mimeType, ok := uploadFormats[strings.ToLower(path.Ext(upload.Filename))]
+	if !ok {
+		return nil, errors.NewInputError(ctx, "Only DOCX and PDF files can be uploaded.")
+	}
+	if convert && mimeType != docxMimeType {
+		return nil, errors.NewInputError(ctx, "Only DOCX files can be converted to Google Docs.")
+	}
+
+	// Only documents inside folders are SOPs, so files directly in the root folder would never be searchable
+	if folderId == s.Source.RootFolderID() {
+		return nil, errors.NewInputError(ctx, "Files must be uploaded into a folder, not the root folder.")
+	}
+
+	if err := s.checkFolderInRoot(ctx, folderId); err != nil {
+		return nil, err
+	}
+
+	item, err := uploadSource.UploadFile(ctx, folderId, upload.Filename, mimeType, upload.File, convert)
+	if err == ErrItemExists {
+		return nil, errors.NewInputError(ctx, "A file with this name already exists in this folder.")
+	} else if err != nil {
+		return nil, sourceError(ctx, "An unexpected error occurred while uploading the file.", err)
+	}
+
+	file, ok := s.newFolderItem(item).(*model.File)
+	if !ok {
+		return nil, errors.NewInternalError(ctx, "An unexpected error occurred while uploading the file.", ErrUnsupportedFormat)
+	}
+
+	s.folderTree.addItem(folderId, file)
+
+	return file, nil
+}
@@ -0,0 +1,201 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func newTestUpload(name string, content []byte) graphql.Upload {
+	return graphql.Upload{File: bytes.NewReader(content), Filename: name, Size: int64(len(content))}
+}
+
+func TestUploadPDF(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "equipment", "Equipment")
+	drive.AddDocument("equipment", "centrifuge", "Centrifuge", "2023-01-01T00:00:00.000Z")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	// Build the cached tree first, so the upload has to be added to it
+	if _, err := service.GetFolderTree(ctx, "", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := service.uploadFile(ctx, "equipment", newTestUpload("Autoclave.pdf", newTestPDF("Autoclave at 121 C")), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Name != "Autoclave.pdf" || file.MimeType != pdfMimeType || file.Kind != model.FileKindPDF {
+		t.Errorf("expected the uploaded PDF, got %#v", file)
+	}
+	if len(drive.Uploads()) != 1 || drive.Uploads()[0].Get("convert") != "false" {
+		t.Errorf("expected a single upload without conversion, got %v", drive.Uploads())
+	}
+
+	// The text that is indexed comes from the uploaded content
+	text, err := service.getFileText(ctx, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text == nil || !strings.Contains(*text, "Autoclave at 121 C") {
+		t.Errorf("expected the text of the uploaded PDF, got %v", text)
+	}
+
+	nodes, err := service.GetFolderTree(ctx, "equipment", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 2 || nodes[0].ID != file.ID || nodes[1].ID != "centrifuge" {
+		t.Errorf("expected the upload to be added to the cached tree in name order, got %#v", nodes)
+	}
+}
+
+func TestUploadConvertsDOCX(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "equipment", "Equipment")
+	service := newTestFileService(drive)
+
+	file, err := service.uploadFile(context.Background(), "equipment", newTestUpload("Centrifuge.docx", []byte("docx")), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Kind != model.FileKindDocument || file.MimeType != "application/vnd.google-apps.document" {
+		t.Errorf("expected the upload to be converted to a Google Doc, got %#v", file)
+	}
+	if len(drive.Uploads()) != 1 || drive.Uploads()[0].Get("convert") != "true" {
+		t.Errorf("expected the upload to ask for conversion, got %v", drive.Uploads())
+	}
+}
+
+func TestUnconvertedDOCXCanBeRead(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "buffers", "Buffers")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	file, err := service.uploadFile(ctx, "buffers", newTestUpload("Tris.docx", newTestDocx(t, `<w:p><w:r><w:t>Dissolve in water</w:t></w:r></w:p>`)), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.MimeType != docxMimeType {
+		t.Fatalf("expected the DOCX file to be stored as is, got %s", file.MimeType)
+	}
+
+	// Drive cannot export the file, so its text comes from the file itself
+	text, err := service.getFileText(ctx, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(*text, "Dissolve in water") {
+		t.Errorf("expected the text of the DOCX file, got %q", *text)
+	}
+
+	content, err := service.GetFileContent(ctx, file.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content, "Dissolve in water") {
+		t.Errorf("expected the content of the DOCX file, got %q", content)
+	}
+
+	for _, format := range []model.DownloadFormat{model.DownloadFormatTxt, model.DownloadFormatMd, model.DownloadFormatDocx} {
+		download, err := service.DownloadFile(ctx, file.ID, nil, format)
+		if err != nil {
+			t.Fatalf("could not download the DOCX file as %s: %v", format, err)
+		}
+		if format == model.DownloadFormatDocx && download.FileName != "Tris.docx" {
+			t.Errorf("expected the name of the DOCX file not to repeat its extension, got %s", download.FileName)
+		}
+		if format != model.DownloadFormatDocx && !strings.Contains(string(download.Content), "Dissolve in water") {
+			t.Errorf("expected the text of the DOCX file as %s, got %q", format, download.Content)
+		}
+	}
+}
+
+func TestUploadedDOCXIsSearchable(t *testing.T) {
+	newTestDB(t)
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("root", "buffers", "Buffers")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	file, err := service.UploadFile(ctx, "buffers", newTestUpload("Tris.docx", newTestDocx(t, `<w:p><w:r><w:t>Dissolve in water</w:t></w:r></w:p>`)), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := service.SearchFiles(ctx, "dissolve", 20, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results.TotalCount != 1 || len(results.Edges) != 1 || results.Edges[0].Node.ID != file.ID {
+		t.Errorf("expected the uploaded file to be found by its text right away, got %#v", results)
+	}
+}
+
+func TestUploadRejectsInvalidUploads(t *testing.T) {
+	drive := newFakeDrive(t, 10)
+	drive.AddFolder("elsewhere", "personal", "Personal")
+	drive.AddFolder("root", "equipment", "Equipment")
+	drive.AddDocument("equipment", "centrifuge", "Centrifuge", "2023-01-01T00:00:00.000Z")
+	service := newTestFileService(drive)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		folderId string
+		filename string
+		convert  bool
+		status   int
+	}{
+		{"unsupported type", "equipment", "notes.txt", false, 400},
+		{"converted PDF", "equipment", "Autoclave.pdf", true, 400},
+		{"root folder", "root", "Autoclave.pdf", false, 400},
+		{"folder outside the root folder", "personal", "Autoclave.pdf", false, 404},
+		{"file instead of a folder", "centrifuge", "Autoclave.pdf", false, 404},
+	}
+
+	for _, test := range tests {
+		_, err := service.uploadFile(ctx, test.folderId, newTestUpload(test.filename, []byte("content")), test.convert)
+		if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != test.status {
+			t.Errorf("%s: expected status %d, got %v", test.name, test.status, err)
+		}
+	}
+
+	if len(drive.Uploads()) != 0 {
+		t.Errorf("expected nothing to be uploaded, got %d uploads", len(drive.Uploads()))
+	}
+}
+
+func TestLocalSourceUpload(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "Equipment", "Centrifuge.md"), []byte("# Centrifuge"))
+	source := &LocalSource{Root: root}
+	service := &FileService{Source: source}
+	ctx := context.Background()
+
+	folderId := source.encodeID("Equipment")
+	file, err := service.uploadFile(ctx, folderId, newTestUpload("../Autoclave.pdf", []byte("%PDF")), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Name != "Autoclave" {
+		t.Errorf("expected the file to be named Autoclave, got %s", file.Name)
+	}
+	if _, err := os.Stat(filepath.Join(root, "Equipment", "Autoclave.pdf")); err != nil {
+		t.Errorf("expected the file to be written inside the folder: %v", err)
+	}
+
+	_, err = service.uploadFile(ctx, folderId, newTestUpload("Autoclave.pdf", []byte("%PDF")), false)
+	if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != 400 {
+		t.Errorf("expected an input error for a duplicate name, got %v", err)
+	}
+}
@@ -0,0 +1,5 @@
+-- Stores the metadata of each cached file, so search results can be returned without calling Drive
+ALTER TABLE file
+    ADD COLUMN IF NOT EXISTS created TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_updated TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_modified_by TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,5 @@
+-- Stores values that need to be kept between background syncs, such as the Drive changes page token
+CREATE TABLE IF NOT EXISTS sync_state (
+    key TEXT PRIMARY KEY,
+    value TEXT NOT NULL
+);
@@ -0,0 +1,10 @@
+-- Records every file removed from the search cache because it was deleted, trashed or moved out of the root folder
+CREATE TABLE IF NOT EXISTS file_prune_log (
+    id SERIAL PRIMARY KEY,
+    file_id TEXT NOT NULL,
+    title TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    pruned_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS file_prune_log_pruned_at ON file_prune_log (pruned_at DESC);
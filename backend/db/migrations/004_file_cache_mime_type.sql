@@ -0,0 +1,3 @@
+-- Stores the type of each cached file, since PDFs are cached alongside Google Docs
+ALTER TABLE file
+    ADD COLUMN IF NOT EXISTS mime_type TEXT NOT NULL DEFAULT '';
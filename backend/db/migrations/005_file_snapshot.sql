@@ -0,0 +1,17 @@
+-- Keeps every version of the text of each cached file, so earlier versions can still be compared after the cache is refreshed
+ALTER TABLE file
+    ADD COLUMN IF NOT EXISTS content_hash TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS file_snapshot (
+    id SERIAL PRIMARY KEY,
+    file_id TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    content_hash TEXT NOT NULL,
+    contents TEXT NOT NULL,
+    captured_at TIMESTAMPTZ NOT NULL,
+    last_updated TIMESTAMPTZ,
+    last_modified_by TEXT NOT NULL DEFAULT '',
+    UNIQUE (file_id, version)
+);
+
+CREATE INDEX IF NOT EXISTS file_snapshot_captured_at ON file_snapshot (captured_at);
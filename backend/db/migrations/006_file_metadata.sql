@@ -0,0 +1,8 @@
+-- Stores the Drive metadata that file cards show, so search results can show it without calling Drive
+ALTER TABLE file
+    ADD COLUMN IF NOT EXISTS size_bytes BIGINT,
+    ADD COLUMN IF NOT EXISTS owners JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS web_view_link TEXT,
+    ADD COLUMN IF NOT EXISTS description TEXT,
+    ADD COLUMN IF NOT EXISTS icon_link TEXT,
+    ADD COLUMN IF NOT EXISTS starred BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,544 @@
+// Package drivetest provides a fake Google Drive v2 API for tests. It serves folder listings, items, exports, downloads,
+// revisions, changes, uploads and folder changes from an in-memory tree, which can be seeded from a fixture directory.
+package drivetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Mime types of the items in the fake Drive
+const (
+	FolderMimeType   = "application/vnd.google-apps.folder"
+	DocumentMimeType = "application/vnd.google-apps.document"
+	ShortcutMimeType = "application/vnd.google-apps.shortcut"
+	DocxMimeType     = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	PDFMimeType      = "application/pdf"
+	HTMLMimeType     = "text/html"
+	TextMimeType     = "text/plain"
+)
+
+// An item in the fake Drive, in the format of the Drive v2 files resource
+type File struct {
+	ID                    string    `json:"id"`
+	Title                 string    `json:"title"`
+	MimeType              string    `json:"mimeType"`
+	CreatedDate           string    `json:"createdDate,omitempty"`
+	ModifiedDate          string    `json:"modifiedDate,omitempty"`
+	LastModifyingUserName string    `json:"lastModifyingUserName,omitempty"`
+	Parents               []*Parent `json:"parents"`
+	Labels                Labels    `json:"labels"`
+	FileSize              string    `json:"fileSize,omitempty"`
+	Owners                []*User   `json:"owners,omitempty"`
+	AlternateLink         string    `json:"alternateLink,omitempty"`
+	Description           string    `json:"description,omitempty"`
+	IconLink              string    `json:"iconLink,omitempty"`
+	// Set on shortcuts to the item they point to
+	ShortcutDetails *ShortcutDetails `json:"shortcutDetails,omitempty"`
+}
+
+type ShortcutDetails struct {
+	TargetID       string `json:"targetId"`
+	TargetMimeType string `json:"targetMimeType,omitempty"`
+}
+
+type Parent struct {
+	ID string `json:"id"`
+}
+
+type Labels struct {
+	Trashed bool `json:"trashed"`
+	Starred bool `json:"starred,omitempty"`
+}
+
+type User struct {
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress,omitempty"`
+}
+
+// A saved version of a file, in the format of the Drive v2 revisions resource
+type Revision struct {
+	ID                    string            `json:"id"`
+	MimeType              string            `json:"mimeType"`
+	ModifiedDate          string            `json:"modifiedDate"`
+	LastModifyingUserName string            `json:"lastModifyingUserName"`
+	ExportLinks           map[string]string `json:"exportLinks,omitempty"`
+	DownloadURL           string            `json:"downloadUrl,omitempty"`
+}
+
+// A change to a single item, in the format of the Drive v2 changes resource
+type Change struct {
+	FileID  string `json:"fileId"`
+	Deleted bool   `json:"deleted"`
+	File    *File  `json:"file"`
+}
+
+// A notification channel, in the format of the Drive v2 channels resource
+type Channel struct {
+	ID         string `json:"id"`
+	ResourceID string `json:"resourceId,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Address    string `json:"address,omitempty"`
+	Token      string `json:"token,omitempty"`
+	Expiration int64  `json:"expiration,string,omitempty"`
+}
+
+// A fake Drive API. Page tokens for folder listings and changes are indexes into the list being paged through.
+type Server struct {
+	// The base URL of the files API, like https://www.googleapis.com/drive/v2
+	URL string
+
+	// The base URL that files are uploaded to, like https://www.googleapis.com/upload/drive/v2
+	UploadURL string
+
+	// How many items are returned in each page of a folder listing or the changes feed
+	PageSize int
+
+	// When set, every request must have this bearer token
+	AccessToken string
+
+	server   *httptest.Server
+	mutex    sync.Mutex
+	requests int
+
+	children  map[string][]*File
+	exports   map[string]map[string][]byte
+	media     map[string][]byte
+	revisions map[string][]*Revision
+	links     map[string][]byte
+	changes   []*Change
+	uploads   []url.Values
+	watched   []*Channel
+	stopped   []string
+	created   int
+	forbidden map[string]bool
+}
+
+var parentQuery = regexp.MustCompile(`^"((?:[^"\\]|\\.)+)" in parents and trashed = false$`)
+
+// Matches an escaped character in a quoted query string
+var queryEscape = regexp.MustCompile(`\\(.)`)
+
+// Starts a fake Drive that is shut down when the test finishes
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		PageSize:  100,
+		children:  map[string][]*File{},
+		exports:   map[string]map[string][]byte{},
+		media:     map[string][]byte{},
+		revisions: map[string][]*Revision{},
+		links:     map[string][]byte{},
+		forbidden: map[string]bool{},
+	}
+
+	s.server = httptest.NewServer(s)
+	t.Cleanup(s.server.Close)
+
+	s.URL = s.server.URL
+	s.UploadURL = s.server.URL + "/upload"
+
+	return s
+}
+
+// Gets a client that can connect to the fake Drive
+func (s *Server) Client() *http.Client {
+	return s.server.Client()
+}
+
+// Adds an item to a folder, setting its parent to the folder
+func (s *Server) AddFile(parent string, file *File) *File {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(file.Parents) == 0 {
+		file.Parents = []*Parent{{ID: parent}}
+	}
+	s.children[parent] = append(s.children[parent], file)
+
+	return file
+}
+
+func (s *Server) AddFolder(parent string, id string, name string) *File {
+	return s.AddFile(parent, &File{ID: id, Title: name, MimeType: FolderMimeType})
+}
+
+// Adds a Google Doc that was last modified at the given time, in Drive's timestamp format
+func (s *Server) AddDocument(parent string, id string, name string, modified string) *File {
+	return s.AddFile(parent, &File{ID: id, Title: name, MimeType: DocumentMimeType, ModifiedDate: modified})
+}
+
+// Adds a shortcut to another item. The target does not need to exist, just like a shortcut to an item that was deleted.
+func (s *Server) AddShortcut(parent string, id string, name string, targetId string) *File {
+	return s.AddFile(parent, &File{ID: id, Title: name, MimeType: ShortcutMimeType, ShortcutDetails: &ShortcutDetails{TargetID: targetId}})
+}
+
+// Makes requests for an item fail with a permission error, like an item that is not shared with the service account.
+// The item is still listed in its folder.
+func (s *Server) Forbid(id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.forbidden[id] = true
+}
+
+// Sets the content of a file when it is exported in the format with the given mime type
+func (s *Server) SetExport(id string, mimeType string, content []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.exports[id] == nil {
+		s.exports[id] = map[string][]byte{}
+	}
+	s.exports[id][mimeType] = content
+}
+
+// Sets the content of a file when it is downloaded as is
+func (s *Server) SetMedia(id string, content []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.media[id] = content
+}
+
+// Adds a revision of a file, along with its content in each export format, keyed by mime type
+func (s *Server) AddRevision(fileId string, revision *Revision, exports map[string][]byte) *Revision {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if revision.ExportLinks == nil {
+		revision.ExportLinks = map[string]string{}
+	}
+	for mimeType, content := range exports {
+		path := fmt.Sprintf("/revision-content/%s/%s/%d", url.PathEscape(fileId), url.PathEscape(revision.ID), len(s.links))
+		s.links[path] = content
+		revision.ExportLinks[mimeType] = s.server.URL + path
+	}
+
+	s.revisions[fileId] = append(s.revisions[fileId], revision)
+	return revision
+}
+
+// Adds a change to the changes feed
+func (s *Server) AddChange(change *Change) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.changes = append(s.changes, change)
+}
+
+// Finds an item in any folder. Returns nil if the item does not exist or is in the trash.
+func (s *Server) File(id string) *File {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.findFile(id)
+}
+
+// Gets how many requests have been made
+func (s *Server) Requests() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.requests
+}
+
+// Gets the query parameters of every upload
+func (s *Server) Uploads() []url.Values {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]url.Values{}, s.uploads...)
+}
+
+// Gets every notification channel that was registered
+func (s *Server) Watched() []*Channel {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]*Channel{}, s.watched...)
+}
+
+// Gets the IDs of every notification channel that was stopped
+func (s *Server) Stopped() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]string{}, s.stopped...)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests++
+
+	if s.AccessToken != "" && r.Header.Get("Authorization") != "Bearer "+s.AccessToken {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/files":
+		if r.Method == http.MethodPost {
+			s.serveCreate(w, r)
+		} else {
+			s.serveChildren(w, r)
+		}
+	case "/upload/files":
+		s.serveUpload(w, r)
+	case "/changes/startPageToken":
+		writeJSON(w, map[string]string{"startPageToken": strconv.Itoa(len(s.changes))})
+	case "/changes":
+		s.serveChanges(w, r)
+	case "/changes/watch":
+		channel := &Channel{}
+		json.NewDecoder(r.Body).Decode(channel)
+		s.watched = append(s.watched, channel)
+		writeJSON(w, &Channel{ID: channel.ID, ResourceID: "resource-" + channel.ID, Expiration: channel.Expiration})
+	case "/channels/stop":
+		channel := &Channel{}
+		json.NewDecoder(r.Body).Decode(channel)
+		s.stopped = append(s.stopped, channel.ID)
+	default:
+		if strings.HasPrefix(r.URL.Path, "/files/") {
+			s.serveFile(w, r)
+		} else if content, ok := s.links[r.URL.Path]; ok {
+			w.Write(content)
+		} else {
+			http.NotFound(w, r)
+		}
+	}
+}
+
+// Serves a single item, its content or its revisions, or changes an item
+func (s *Server) serveFile(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/files/"), "/")
+	file := s.findFile(parts[0])
+	if file == nil {
+		http.NotFound(w, r)
+		return
+	} else if s.forbidden[file.ID] {
+		http.Error(w, `{"error":{"errors":[{"reason":"insufficientFilePermissions"}],"code":403}}`, http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodPatch:
+		s.serveUpdate(w, r, file)
+		return
+	case len(parts) == 1 && r.URL.Query().Get("alt") == "media":
+		if media, ok := s.media[file.ID]; ok {
+			w.Write(media)
+			return
+		}
+	case len(parts) == 1:
+		writeJSON(w, file)
+		return
+	case len(parts) == 2 && parts[1] == "trash" && r.Method == http.MethodPost:
+		file.Labels.Trashed = true
+		for _, parent := range file.Parents {
+			s.children[parent.ID] = removeFile(s.children[parent.ID], file.ID)
+		}
+		writeJSON(w, file)
+		return
+	case len(parts) == 2 && parts[1] == "export":
+		s.serveExport(w, r, file)
+		return
+	case len(parts) == 2 && parts[1] == "revisions":
+		writeJSON(w, map[string]interface{}{"items": s.revisions[file.ID]})
+		return
+	case len(parts) == 3 && parts[1] == "revisions":
+		for _, revision := range s.revisions[file.ID] {
+			if revision.ID == parts[2] {
+				writeJSON(w, revision)
+				return
+			}
+		}
+	}
+
+	http.NotFound(w, r)
+}
+
+// Serves the content of a file in an export format. Like Drive, files that are not Google Docs files cannot be exported, and
+// formats that a Google Docs file cannot be converted to are a bad request.
+func (s *Server) serveExport(w http.ResponseWriter, r *http.Request, file *File) {
+	if !strings.HasPrefix(file.MimeType, "application/vnd.google-apps.") {
+		http.Error(w, `{"error":{"errors":[{"reason":"fileNotExportable"}],"code":403}}`, http.StatusForbidden)
+		return
+	}
+
+	content, ok := s.exports[file.ID][r.URL.Query().Get("mimeType")]
+	if !ok {
+		http.Error(w, `{"error":{"errors":[{"reason":"badRequest"}],"code":400}}`, http.StatusBadRequest)
+		return
+	}
+
+	w.Write(content)
+}
+
+func (s *Server) serveChildren(w http.ResponseWriter, r *http.Request) {
+	match := parentQuery.FindStringSubmatch(r.URL.Query().Get("q"))
+	if match == nil {
+		http.Error(w, "unsupported query", http.StatusBadRequest)
+		return
+	}
+	items := s.children[queryEscape.ReplaceAllString(match[1], "$1")]
+
+	start := 0
+	if token := r.URL.Query().Get("pageToken"); token != "" {
+		start, _ = strconv.Atoi(token)
+	}
+	end := start + s.PageSize
+	if end > len(items) {
+		end = len(items)
+	}
+
+	res := map[string]interface{}{"items": items[start:end]}
+	if end < len(items) {
+		res["nextPageToken"] = strconv.Itoa(end)
+	}
+
+	writeJSON(w, res)
+}
+
+func (s *Server) serveChanges(w http.ResponseWriter, r *http.Request) {
+	start, err := strconv.Atoi(r.URL.Query().Get("pageToken"))
+	if err != nil || start > len(s.changes) {
+		http.Error(w, `{"error":{"code":400,"message":"Invalid Value"}}`, http.StatusBadRequest)
+		return
+	}
+
+	end := start + s.PageSize
+	if end > len(s.changes) {
+		end = len(s.changes)
+	}
+
+	res := map[string]interface{}{"items": s.changes[start:end]}
+	if end < len(s.changes) {
+		res["nextPageToken"] = strconv.Itoa(end)
+	} else {
+		res["newStartPageToken"] = strconv.Itoa(len(s.changes))
+	}
+
+	writeJSON(w, res)
+}
+
+// Creates a folder
+func (s *Server) serveCreate(w http.ResponseWriter, r *http.Request) {
+	file := &File{}
+	if err := json.NewDecoder(r.Body).Decode(file); err != nil || len(file.Parents) != 1 {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	s.created++
+	file.ID = fmt.Sprintf("created-%d", s.created)
+	s.children[file.Parents[0].ID] = append(s.children[file.Parents[0].ID], file)
+
+	writeJSON(w, file)
+}
+
+// Renames an item, or moves it with the addParents and removeParents parameters
+func (s *Server) serveUpdate(w http.ResponseWriter, r *http.Request, file *File) {
+	changes := &File{}
+	json.NewDecoder(r.Body).Decode(changes)
+	if changes.Title != "" {
+		file.Title = changes.Title
+	}
+
+	if removeParents := r.URL.Query().Get("removeParents"); removeParents != "" {
+		for _, parentId := range strings.Split(removeParents, ",") {
+			s.children[parentId] = removeFile(s.children[parentId], file.ID)
+		}
+		file.Parents = []*Parent{}
+	}
+	if addParent := r.URL.Query().Get("addParents"); addParent != "" {
+		s.children[addParent] = append(s.children[addParent], file)
+		file.Parents = append(file.Parents, &Parent{ID: addParent})
+	}
+
+	writeJSON(w, file)
+}
+
+// Creates a file from a multipart upload, converting DOCX files to Google Docs when asked to
+func (s *Server) serveUpload(w http.ResponseWriter, r *http.Request) {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if r.Method != http.MethodPost || r.URL.Query().Get("uploadType") != "multipart" || err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	s.uploads = append(s.uploads, r.URL.Query())
+
+	reader := multipart.NewReader(r.Body, params["boundary"])
+	part, err := reader.NextPart()
+	if err != nil {
+		http.Error(w, "missing metadata", http.StatusBadRequest)
+		return
+	}
+	file := &File{}
+	if err := json.NewDecoder(part).Decode(file); err != nil || len(file.Parents) != 1 {
+		http.Error(w, "invalid metadata", http.StatusBadRequest)
+		return
+	}
+
+	part, err = reader.NextPart()
+	if err != nil {
+		http.Error(w, "missing content", http.StatusBadRequest)
+		return
+	}
+	content, _ := io.ReadAll(part)
+
+	file.ID = fmt.Sprintf("upload-%d", len(s.uploads))
+	file.CreatedDate = "2023-04-01T00:00:00.000Z"
+	file.ModifiedDate = "2023-04-01T00:00:00.000Z"
+	if r.URL.Query().Get("convert") == "true" && file.MimeType == DocxMimeType {
+		file.MimeType = DocumentMimeType
+		file.Title = strings.TrimSuffix(file.Title, ".docx")
+	} else {
+		file.FileSize = strconv.Itoa(len(content))
+	}
+
+	s.media[file.ID] = content
+	s.children[file.Parents[0].ID] = append(s.children[file.Parents[0].ID], file)
+
+	writeJSON(w, file)
+}
+
+// Finds an item in any folder. Must be called while holding the lock.
+func (s *Server) findFile(id string) *File {
+	for _, files := range s.children {
+		for _, file := range files {
+			if file.ID == id {
+				return file
+			}
+		}
+	}
+
+	return nil
+}
+
+func removeFile(files []*File, id string) []*File {
+	remaining := []*File{}
+	for _, file := range files {
+		if file.ID != id {
+			remaining = append(remaining, file)
+		}
+	}
+
+	return remaining
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(value)
+}
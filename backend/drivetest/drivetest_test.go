@@ -0,0 +1,70 @@
+package drivetest
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func get(t *testing.T, s *Server, path string) (int, string) {
+	t.Helper()
+
+	res, err := s.Client().Get(s.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return res.StatusCode, string(body)
+}
+
+func TestLoadFixture(t *testing.T) {
+	s := NewServer(t)
+	if err := s.LoadFixture(Fixture, "root"); err != nil {
+		t.Fatal(err)
+	}
+
+	file := s.File("protocols-buffers-pbs")
+	if file == nil || file.Title != "PBS" || file.MimeType != DocumentMimeType || file.Parents[0].ID != "protocols-buffers" {
+		t.Fatalf("unexpected fixture item: %#v", file)
+	}
+
+	status, body := get(t, s, "/files/protocols-buffers-pbs/export?mimeType="+url.QueryEscape(TextMimeType))
+	if status != http.StatusOK || body != "PBS Dissolve 8 g NaCl, 0.2 g KCl, 1.44 g Na 2 HPO 4 and 0.24 g KH 2 PO 4 in 800 mL water. Adjust the pH to 7.4 & fill to 1 L." {
+		t.Errorf("unexpected text export: %d %s", status, body)
+	}
+
+	status, _ = get(t, s, "/files/protocols-buffers-pbs/export?mimeType="+url.QueryEscape(PDFMimeType))
+	if status != http.StatusBadRequest {
+		t.Errorf("expected a bad request for a format without an export, got %d", status)
+	}
+
+	status, _ = get(t, s, "/files/missing")
+	if status != http.StatusNotFound {
+		t.Errorf("expected a missing item to be not found, got %d", status)
+	}
+}
+
+func TestChildrenArePaged(t *testing.T) {
+	s := NewServer(t)
+	s.PageSize = 1
+	s.AddFolder("root", "a", "A")
+	s.AddFolder("root", "b", "B")
+
+	query := url.QueryEscape(`"root" in parents and trashed = false`)
+	_, body := get(t, s, "/files?q="+query)
+	if body != `{"items":[{"id":"a","title":"A","mimeType":"application/vnd.google-apps.folder","parents":[{"id":"root"}],"labels":{"trashed":false}}],"nextPageToken":"1"}`+"\n" {
+		t.Errorf("unexpected first page: %s", body)
+	}
+
+	_, body = get(t, s, "/files?pageToken=1&q="+query)
+	if body != `{"items":[{"id":"b","title":"B","mimeType":"application/vnd.google-apps.folder","parents":[{"id":"root"}],"labels":{"trashed":false}}]}`+"\n" {
+		t.Errorf("unexpected last page: %s", body)
+	}
+}
@@ -0,0 +1,105 @@
+package drivetest
+
+import (
+	"embed"
+	"html"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// The timestamp given to every item loaded from a fixture
+const FixtureTimestamp = "2023-01-01T00:00:00.000Z"
+
+//go:embed testdata/sops
+var fixtureFiles embed.FS
+
+// A small tree of SOPs:
+//
+//	Equipment/Autoclave.html
+//	Equipment/Centrifuge.html
+//	Protocols/Buffers/PBS.html
+//	Protocols/PCR.html
+//	Safety.html
+var Fixture fs.FS
+
+func init() {
+	fixture, err := fs.Sub(fixtureFiles, "testdata/sops")
+	if err != nil {
+		panic(err)
+	}
+
+	Fixture = fixture
+}
+
+var (
+	nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+	htmlTag         = regexp.MustCompile(`<[^>]*>`)
+	whitespace      = regexp.MustCompile(`\s+`)
+)
+
+// Adds every folder and file in a directory tree to the fake Drive, inside the folder with the given ID. Directories become
+// folders, .html files become Google Docs that can be exported as HTML and plain text, and .pdf and .docx files are stored as
+// is. Other files are skipped. Each item's ID is its path without the extension, in lower case with dashes between words, so
+// Protocols/Buffers/PBS.html has the ID protocols-buffers-pbs.
+func (s *Server) LoadFixture(fsys fs.FS, rootId string) error {
+	return fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || name == "." {
+			return err
+		}
+
+		parent := rootId
+		if dir := path.Dir(name); dir != "." {
+			parent = FixtureID(dir)
+		}
+
+		id := FixtureID(name)
+		file := &File{ID: id, CreatedDate: FixtureTimestamp, ModifiedDate: FixtureTimestamp, LastModifyingUserName: "Lab Manager"}
+
+		if entry.IsDir() {
+			file.Title = entry.Name()
+			file.MimeType = FolderMimeType
+			s.AddFile(parent, file)
+			return nil
+		}
+
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		switch path.Ext(name) {
+		case ".html":
+			file.Title = strings.TrimSuffix(entry.Name(), ".html")
+			file.MimeType = DocumentMimeType
+			s.AddFile(parent, file)
+			s.SetExport(id, HTMLMimeType, content)
+			s.SetExport(id, TextMimeType, []byte(htmlText(string(content))))
+		case ".pdf":
+			file.Title = entry.Name()
+			file.MimeType = PDFMimeType
+			s.AddFile(parent, file)
+			s.SetMedia(id, content)
+		case ".docx":
+			file.Title = entry.Name()
+			file.MimeType = DocxMimeType
+			s.AddFile(parent, file)
+			s.SetMedia(id, content)
+		}
+
+		return nil
+	})
+}
+
+// Gets the ID of an item loaded from a fixture, from its path in the fixture
+func FixtureID(name string) string {
+	name = strings.TrimSuffix(name, path.Ext(name))
+	return strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// Gets the text of an HTML document, roughly the way Drive exports a Google Doc as plain text
+func htmlText(document string) string {
+	text := htmlTag.ReplaceAllString(document, " ")
+	return strings.TrimSpace(whitespace.ReplaceAllString(html.UnescapeString(text), " "))
+}
@@ -66,6 +66,17 @@ func NewUnauthorizedError(ctx context.Context, message string) *gqlerror.Error {
 	}
 }
 
+// Create a new unavailable error to indicate a service the server depends on cannot be used right now
+func NewUnavailableError(ctx context.Context, message string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: message,
+		Extensions: map[string]interface{}{
+			"status": 503,
+		},
+	}
+}
+
 // Create a new input error to indicate the user supplied bad input
 func NewInputError(ctx context.Context, message string) *gqlerror.Error {
 	return &gqlerror.Error{
@@ -2,11 +2,25 @@
 
 package model
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
 // An item in a folder
 type FolderItem interface {
 	IsFolderItem()
 }
 
+// A piece of text in a diff
+type DiffSegment struct {
+	// Whether the text was inserted, deleted or unchanged
+	Type DiffSegmentType `json:"type"`
+	// The text of the segment, including any line breaks
+	Text string `json:"text"`
+}
+
 // An SOP file
 type File struct {
 	// The ID of the file (from Google Drive)
@@ -19,22 +33,154 @@ type File struct {
 	LastUpdated string `json:"lastUpdated"`
 	// The name of the user that last modified the file
 	LastModifiedBy string `json:"lastModifiedBy"`
+	// The mime type of the file, such as application/pdf
+	MimeType string `json:"mimeType"`
+	// The kind of file, which tells clients how to display it
+	Kind FileKind `json:"kind"`
+	// Whether the file was listed in its folder through a Drive shortcut, rather than being stored there. The ID, name and
+	// other fields are those of the file the shortcut points to.
+	IsShortcut bool `json:"isShortcut"`
+	// The size of the file in bytes, or null for Google Docs, Sheets and Slides, which do not use any storage
+	SizeBytes *int64 `json:"sizeBytes"`
+	// The users that own the file in Google Drive
+	Owners []*FileOwner `json:"owners"`
+	// The URL to open the file in Google Drive, or null if the file is not stored in Google Drive
+	WebViewLink *string `json:"webViewLink"`
+	// The description of the file from Google Drive, or null if it has none
+	Description *string `json:"description"`
+	// The URL of the icon Google Drive shows for the file's type, or null if the file is not stored in Google Drive
+	IconLink *string `json:"iconLink"`
+	// Whether the file is starred in Google Drive by the service account
+	Starred bool `json:"starred"`
+	// The URL to download the file from in the given format. Downloads are only available to logged in users.
+	DownloadURL string `json:"downloadUrl"`
+	// The content of the file as sanitized HTML, so it can be read inside the organizer. Links to other SOPs point to their
+	// page in the organizer, and images are loaded through the server. Available to logged in users only.
+	Content string `json:"content"`
+	// The ID of the revision the file was fetched at, or null for the current version
+	Revision *string `json:"revision"`
+	// Every saved revision of the file, most recent first. Available to logged in users only.
+	Revisions []*Revision `json:"revisions"`
+	// Every version of the file's text that has been saved in the search cache, most recent first. Available to logged in users only.
+	Snapshots []*Snapshot `json:"snapshots"`
+	// The folders that contain this file, starting with the folder directly inside the root folder and ending with this file's parent
+	Path []*Folder `json:"path"`
+	// The folder that contains this file, or null if it is directly inside the root folder
+	Parent *Folder `json:"parent"`
 }
 
 func (File) IsFolderItem() {}
 
+// A page of files, in the format of a Relay connection
+type FileConnection struct {
+	// The files in this page
+	Edges []*FileEdge `json:"edges"`
+	// Whether there are more files after this page, and the cursor to get them with
+	PageInfo *PageInfo `json:"pageInfo"`
+	// The total number of files across every page
+	TotalCount int `json:"totalCount"`
+}
+
+// The differences between two versions of a file's text
+type FileDiff struct {
+	// The ID of the file (from Google Drive)
+	FileID string `json:"fileId"`
+	// The version the changes are from
+	From string `json:"from"`
+	// The version the changes are to
+	To string `json:"to"`
+	// The text of both versions split into segments, in order. Unchanged segments are in both versions, deleted segments are only
+	// in the from version, and inserted segments are only in the to version.
+	Segments []*DiffSegment `json:"segments"`
+}
+
+// A file in a FileConnection
+type FileEdge struct {
+	// The cursor to pass as after to get the files after this one
+	Cursor string `json:"cursor"`
+	Node   *File  `json:"node"`
+}
+
+// A user that owns a file in Google Drive
+type FileOwner struct {
+	// The display name of the user
+	Name string `json:"name"`
+	// The email address of the user, or null if Drive does not share it
+	Email *string `json:"email"`
+}
+
 // A folder contains a group of files and nested folders
 type Folder struct {
 	// The ID of the folder (from Google Drive)
 	ID string `json:"id"`
 	// The name of the folder
 	Name string `json:"name"`
-	// A list of files and nested folders
+	// Whether the folder was listed in its parent folder through a Drive shortcut, rather than being stored there
+	IsShortcut bool `json:"isShortcut"`
+	// A list of files and nested folders, sorted by name unless sortBy is given. When types is given, only items of those types are
+	// returned, and when modifiedAfter is given, only items that were last modified after that timestamp (such as
+	// 2023-01-01T00:00:00Z) are returned.
 	Contents []FolderItem `json:"contents"`
+	// The folders that contain this folder, starting with the folder directly inside the root folder and ending with this folder's parent
+	Path []*Folder `json:"path"`
+	// The folder that contains this folder, or null if it is directly inside the root folder
+	Parent *Folder `json:"parent"`
 }
 
 func (Folder) IsFolderItem() {}
 
+// An item in the folder tree
+type FolderTreeNode struct {
+	// The ID of the item (from Google Drive)
+	ID string `json:"id"`
+	// The name of the item
+	Name string `json:"name"`
+	// The ID of the folder that contains the item
+	ParentID string `json:"parentId"`
+	// How many levels below the requested folder the item is, starting at 1 for the items directly inside it
+	Depth int `json:"depth"`
+	// The folder or file
+	Item FolderItem `json:"item"`
+}
+
+// Information about a page of a Relay connection
+type PageInfo struct {
+	// Whether there are more items after this page
+	HasNextPage bool `json:"hasNextPage"`
+	// Whether there are items before this page, which is the case when an after cursor was given
+	HasPreviousPage bool `json:"hasPreviousPage"`
+	// The cursor of the first item in this page, or null if the page is empty
+	StartCursor *string `json:"startCursor"`
+	// The cursor of the last item in this page, or null if the page is empty
+	EndCursor *string `json:"endCursor"`
+}
+
+// A file that was removed from the search cache
+type PrunedFile struct {
+	// The ID of the file (from Google Drive)
+	ID string `json:"id"`
+	// The name of the file when it was removed
+	Name string `json:"name"`
+	// Why the file was removed
+	Reason string `json:"reason"`
+	// The timestamp of when the file was removed
+	PrunedAt string `json:"prunedAt"`
+}
+
+// A saved version of a file, from the Drive revision history
+type Revision struct {
+	// The ID of the revision (from Google Drive)
+	ID string `json:"id"`
+	// The ID of the file the revision belongs to
+	FileID string `json:"fileId"`
+	// The timestamp of when the revision was saved
+	Timestamp string `json:"timestamp"`
+	// The name of the user that saved the revision
+	Author string `json:"author"`
+	// The URL to download the file as it was at this revision. Downloads are only available to logged in users.
+	ExportLink string `json:"exportLink"`
+}
+
 // Results returned when searching for files
 type SearchResult struct {
 	// The ID of the file (from Google Drive)
@@ -43,6 +189,36 @@ type SearchResult struct {
 	Name string `json:"name"`
 }
 
+// A version of a file's text that was saved in the search cache. A new snapshot is only saved when the text changes.
+type Snapshot struct {
+	// The version number of the snapshot, starting at 1 for the first snapshot of a file
+	Version int `json:"version"`
+	// The SHA-256 hash of the text
+	ContentHash string `json:"contentHash"`
+	// The timestamp of when the snapshot was saved
+	CapturedAt string `json:"capturedAt"`
+	// The timestamp of when the file was last updated before the snapshot was saved
+	LastUpdated string `json:"lastUpdated"`
+	// The name of the user that last modified the file before the snapshot was saved
+	LastModifiedBy string `json:"lastModifiedBy"`
+}
+
+// The status of the background job that keeps the search cache up to date
+type SyncStatus struct {
+	// Indicates whether a sync is currently running
+	Running bool `json:"running"`
+	// The timestamp of when the most recent sync started
+	LastRun *string `json:"lastRun"`
+	// How long the most recent sync took, in milliseconds
+	DurationMs *int `json:"durationMs"`
+	// The number of documents that were added to or updated in the cache by the most recent sync
+	DocumentsUpdated int `json:"documentsUpdated"`
+	// The number of documents that were removed from the cache by the most recent sync
+	DocumentsPruned int `json:"documentsPruned"`
+	// Errors that occurred during the most recent sync
+	Errors []string `json:"errors"`
+}
+
 type User struct {
 	// The ID of the user
 	ID string `json:"id"`
@@ -59,3 +235,277 @@ type User struct {
 	// Indicates the user should be prompted to change their password when they log in
 	ShouldForcePasswordChange *bool `json:"shouldForcePasswordChange"`
 }
+
+// How a piece of text changed between two versions
+type DiffSegmentType string
+
+const (
+	DiffSegmentTypeInserted  DiffSegmentType = "INSERTED"
+	DiffSegmentTypeDeleted   DiffSegmentType = "DELETED"
+	DiffSegmentTypeUnchanged DiffSegmentType = "UNCHANGED"
+)
+
+var AllDiffSegmentType = []DiffSegmentType{
+	DiffSegmentTypeInserted,
+	DiffSegmentTypeDeleted,
+	DiffSegmentTypeUnchanged,
+}
+
+func (e DiffSegmentType) IsValid() bool {
+	switch e {
+	case DiffSegmentTypeInserted, DiffSegmentTypeDeleted, DiffSegmentTypeUnchanged:
+		return true
+	}
+	return false
+}
+
+func (e DiffSegmentType) String() string {
+	return string(e)
+}
+
+func (e *DiffSegmentType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DiffSegmentType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DiffSegmentType", str)
+	}
+	return nil
+}
+
+func (e DiffSegmentType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The formats that files can be downloaded in
+type DownloadFormat string
+
+const (
+	DownloadFormatPDF  DownloadFormat = "PDF"
+	DownloadFormatDocx DownloadFormat = "DOCX"
+	DownloadFormatMd   DownloadFormat = "MD"
+	DownloadFormatTxt  DownloadFormat = "TXT"
+)
+
+var AllDownloadFormat = []DownloadFormat{
+	DownloadFormatPDF,
+	DownloadFormatDocx,
+	DownloadFormatMd,
+	DownloadFormatTxt,
+}
+
+func (e DownloadFormat) IsValid() bool {
+	switch e {
+	case DownloadFormatPDF, DownloadFormatDocx, DownloadFormatMd, DownloadFormatTxt:
+		return true
+	}
+	return false
+}
+
+func (e DownloadFormat) String() string {
+	return string(e)
+}
+
+func (e *DownloadFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DownloadFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DownloadFormat", str)
+	}
+	return nil
+}
+
+func (e DownloadFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The kinds of files that can be SOPs
+type FileKind string
+
+const (
+	// A Google Doc, or an HTML, Markdown or Word document
+	FileKindDocument FileKind = "DOCUMENT"
+	// A PDF
+	FileKindPDF FileKind = "PDF"
+	// A Google Sheets spreadsheet
+	FileKindSpreadsheet FileKind = "SPREADSHEET"
+	// A Google Slides presentation
+	FileKindPresentation FileKind = "PRESENTATION"
+)
+
+var AllFileKind = []FileKind{
+	FileKindDocument,
+	FileKindPDF,
+	FileKindSpreadsheet,
+	FileKindPresentation,
+}
+
+func (e FileKind) IsValid() bool {
+	switch e {
+	case FileKindDocument, FileKindPDF, FileKindSpreadsheet, FileKindPresentation:
+		return true
+	}
+	return false
+}
+
+func (e FileKind) String() string {
+	return string(e)
+}
+
+func (e *FileKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FileKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FileKind", str)
+	}
+	return nil
+}
+
+func (e FileKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The types of items in a folder, which the contents of a folder can be filtered by
+type FolderItemType string
+
+const (
+	FolderItemTypeFolder       FolderItemType = "FOLDER"
+	FolderItemTypeDocument     FolderItemType = "DOCUMENT"
+	FolderItemTypePDF          FolderItemType = "PDF"
+	FolderItemTypeSpreadsheet  FolderItemType = "SPREADSHEET"
+	FolderItemTypePresentation FolderItemType = "PRESENTATION"
+)
+
+var AllFolderItemType = []FolderItemType{
+	FolderItemTypeFolder,
+	FolderItemTypeDocument,
+	FolderItemTypePDF,
+	FolderItemTypeSpreadsheet,
+	FolderItemTypePresentation,
+}
+
+func (e FolderItemType) IsValid() bool {
+	switch e {
+	case FolderItemTypeFolder, FolderItemTypeDocument, FolderItemTypePDF, FolderItemTypeSpreadsheet, FolderItemTypePresentation:
+		return true
+	}
+	return false
+}
+
+func (e FolderItemType) String() string {
+	return string(e)
+}
+
+func (e *FolderItemType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FolderItemType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FolderItemType", str)
+	}
+	return nil
+}
+
+func (e FolderItemType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The fields that the contents of a folder can be sorted by. Items with the same timestamp are sorted by name.
+type FolderSortField string
+
+const (
+	FolderSortFieldName    FolderSortField = "NAME"
+	FolderSortFieldUpdated FolderSortField = "UPDATED"
+	FolderSortFieldCreated FolderSortField = "CREATED"
+)
+
+var AllFolderSortField = []FolderSortField{
+	FolderSortFieldName,
+	FolderSortFieldUpdated,
+	FolderSortFieldCreated,
+}
+
+func (e FolderSortField) IsValid() bool {
+	switch e {
+	case FolderSortFieldName, FolderSortFieldUpdated, FolderSortFieldCreated:
+		return true
+	}
+	return false
+}
+
+func (e FolderSortField) String() string {
+	return string(e)
+}
+
+func (e *FolderSortField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FolderSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FolderSortField", str)
+	}
+	return nil
+}
+
+func (e FolderSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The directions that lists can be sorted in
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "ASC"
+	SortOrderDesc SortOrder = "DESC"
+)
+
+var AllSortOrder = []SortOrder{
+	SortOrderAsc,
+	SortOrderDesc,
+}
+
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderAsc, SortOrderDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortOrder) String() string {
+	return string(e)
+}
+
+func (e *SortOrder) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortOrder", str)
+	}
+	return nil
+}
+
+func (e SortOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
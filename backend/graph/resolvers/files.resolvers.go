@@ -7,13 +7,86 @@ package graph
 import (
 	"context"
 
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/auth"
+	errs "git.las.iastate.edu/SeniorDesignComS/2023spr/sop/errors"
 	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/generated"
 	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/99designs/gqlgen/graphql"
 )
 
+// DownloadURL is the resolver for the downloadUrl field.
+func (r *fileResolver) DownloadURL(ctx context.Context, obj *model.File, format model.DownloadFormat) (string, error) {
+	return downloadURL(obj.ID, obj.Revision, format), nil
+}
+
+// Content is the resolver for the content field.
+func (r *fileResolver) Content(ctx context.Context, obj *model.File) (string, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return "", errs.NewUnauthorizedError(ctx, "You must be logged in to read files.")
+	}
+
+	content, err := r.FileService.GetFileContent(ctx, obj.ID, obj.Revision)
+	if err != nil {
+		return "", err
+	}
+
+	return content, nil
+}
+
+// Revisions is the resolver for the revisions field.
+func (r *fileResolver) Revisions(ctx context.Context, obj *model.File) ([]*model.Revision, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to view the revision history.")
+	}
+
+	revisions, err := r.FileService.GetFileRevisions(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// Snapshots is the resolver for the snapshots field.
+func (r *fileResolver) Snapshots(ctx context.Context, obj *model.File) ([]*model.Snapshot, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to view the snapshot history.")
+	}
+
+	snapshots, err := r.FileService.GetFileSnapshots(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// Path is the resolver for the path field.
+func (r *fileResolver) Path(ctx context.Context, obj *model.File) ([]*model.Folder, error) {
+	path, err := r.FileService.GetPath(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return path, nil
+}
+
+// Parent is the resolver for the parent field.
+func (r *fileResolver) Parent(ctx context.Context, obj *model.File) (*model.Folder, error) {
+	parent, err := r.FileService.GetParent(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return parent, nil
+}
+
 // Contents is the resolver for the contents field.
-func (r *folderResolver) Contents(ctx context.Context, obj *model.Folder) ([]model.FolderItem, error) {
-	contents, err := r.FileService.GetFolderContents(ctx, obj.ID)
+func (r *folderResolver) Contents(ctx context.Context, obj *model.Folder, sortBy model.FolderSortField, order model.SortOrder, types []model.FolderItemType, modifiedAfter *string) ([]model.FolderItem, error) {
+	contents, err := r.FileService.GetFolderContents(ctx, obj.ID, sortBy, order, types, modifiedAfter)
 	if err != nil {
 		return nil, err
 	}
@@ -21,6 +94,121 @@ func (r *folderResolver) Contents(ctx context.Context, obj *model.Folder) ([]mod
 	return contents, nil
 }
 
+// Path is the resolver for the path field.
+func (r *folderResolver) Path(ctx context.Context, obj *model.Folder) ([]*model.Folder, error) {
+	path, err := r.FileService.GetPath(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return path, nil
+}
+
+// Parent is the resolver for the parent field.
+func (r *folderResolver) Parent(ctx context.Context, obj *model.Folder) (*model.Folder, error) {
+	parent, err := r.FileService.GetParent(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return parent, nil
+}
+
+// UploadFile is the resolver for the uploadFile field.
+func (r *mutationResolver) UploadFile(ctx context.Context, folderID string, file graphql.Upload, convert *bool) (*model.File, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to upload files.")
+	}
+
+	if !auth.IsAdmin(authUser) {
+		return nil, errs.NewForbiddenError(ctx, "You do not have permission to upload files.")
+	}
+
+	uploaded, err := r.FileService.UploadFile(ctx, folderID, file, convert != nil && *convert)
+	if err != nil {
+		return nil, err
+	}
+
+	return uploaded, nil
+}
+
+// CreateFolder is the resolver for the createFolder field.
+func (r *mutationResolver) CreateFolder(ctx context.Context, parentID string, name string) (*model.Folder, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to create folders.")
+	}
+
+	if !auth.IsAdmin(authUser) {
+		return nil, errs.NewForbiddenError(ctx, "You do not have permission to create folders.")
+	}
+
+	folder, err := r.FileService.CreateFolder(ctx, parentID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return folder, nil
+}
+
+// RenameItem is the resolver for the renameItem field.
+func (r *mutationResolver) RenameItem(ctx context.Context, id string, name string) (model.FolderItem, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to rename folders and files.")
+	}
+
+	if !auth.IsAdmin(authUser) {
+		return nil, errs.NewForbiddenError(ctx, "You do not have permission to rename folders and files.")
+	}
+
+	item, err := r.FileService.RenameItem(ctx, id, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// MoveItem is the resolver for the moveItem field.
+func (r *mutationResolver) MoveItem(ctx context.Context, id string, folderID string) (model.FolderItem, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to move folders and files.")
+	}
+
+	if !auth.IsAdmin(authUser) {
+		return nil, errs.NewForbiddenError(ctx, "You do not have permission to move folders and files.")
+	}
+
+	item, err := r.FileService.MoveItem(ctx, id, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// TrashItem is the resolver for the trashItem field.
+func (r *mutationResolver) TrashItem(ctx context.Context, id string) (bool, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return false, errs.NewUnauthorizedError(ctx, "You must be logged in to move folders and files to the trash.")
+	}
+
+	if !auth.IsAdmin(authUser) {
+		return false, errs.NewForbiddenError(ctx, "You do not have permission to move folders and files to the trash.")
+	}
+
+	trashed, err := r.FileService.TrashItem(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return trashed, nil
+}
+
 // Folders is the resolver for the folders field.
 func (r *queryResolver) Folders(ctx context.Context) ([]*model.Folder, error) {
 	folders, err := r.FileService.GetAllFolders(ctx)
@@ -31,6 +219,29 @@ func (r *queryResolver) Folders(ctx context.Context) ([]*model.Folder, error) {
 	return folders, nil
 }
 
+// FolderTree is the resolver for the folderTree field.
+func (r *queryResolver) FolderTree(ctx context.Context, rootID *string, depth *int) ([]*model.FolderTreeNode, error) {
+	maxDepth := 0
+	if depth != nil {
+		if *depth < 1 {
+			return nil, errs.NewInputError(ctx, "The depth must be at least 1.")
+		}
+		maxDepth = *depth
+	}
+
+	folderId := ""
+	if rootID != nil {
+		folderId = *rootID
+	}
+
+	nodes, err := r.FileService.GetFolderTree(ctx, folderId, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
 // Folder is the resolver for the folder field.
 func (r *queryResolver) Folder(ctx context.Context, id string) (*model.Folder, error) {
 	folder, err := r.FileService.GetFolderById(ctx, id)
@@ -42,7 +253,21 @@ func (r *queryResolver) Folder(ctx context.Context, id string) (*model.Folder, e
 }
 
 // File is the resolver for the file field.
-func (r *queryResolver) File(ctx context.Context, id string) (*model.File, error) {
+func (r *queryResolver) File(ctx context.Context, id string, revision *string) (*model.File, error) {
+	if revision != nil {
+		authUser := auth.GetUserFromContext(ctx)
+		if authUser == nil {
+			return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to view the revision history.")
+		}
+
+		file, err := r.FileService.GetFileRevision(ctx, id, *revision)
+		if err != nil {
+			return nil, err
+		}
+
+		return file, nil
+	}
+
 	file, err := r.FileService.GetFileById(ctx, id)
 	if err != nil {
 		return nil, err
@@ -52,8 +277,8 @@ func (r *queryResolver) File(ctx context.Context, id string) (*model.File, error
 }
 
 // Search is the resolver for the search field.
-func (r *queryResolver) Search(ctx context.Context, query string) ([]*model.File, error) {
-	results, err := r.FileService.SearchFiles(ctx, query)
+func (r *queryResolver) Search(ctx context.Context, query string, first int, after *string) (*model.FileConnection, error) {
+	results, err := r.FileService.SearchFiles(ctx, query, first, after)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +287,8 @@ func (r *queryResolver) Search(ctx context.Context, query string) ([]*model.File
 }
 
 // ListFilesByDate is the resolver for the listFilesByDate field.
-func (r *queryResolver) ListFilesByDate(ctx context.Context) ([]*model.File, error) {
-	files, err := r.FileService.ListFilesByDate(ctx)
+func (r *queryResolver) ListFilesByDate(ctx context.Context, first int, after *string) (*model.FileConnection, error) {
+	files, err := r.FileService.ListFilesByDate(ctx, first, after)
 	if err != nil {
 		return nil, err
 	}
@@ -71,11 +296,85 @@ func (r *queryResolver) ListFilesByDate(ctx context.Context) ([]*model.File, err
 	return files, nil
 }
 
+// FileDiff is the resolver for the fileDiff field.
+func (r *queryResolver) FileDiff(ctx context.Context, id string, from string, to string) (*model.FileDiff, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to compare versions of a file.")
+	}
+
+	diff, err := r.FileService.DiffFile(ctx, id, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// SyncStatus is the resolver for the syncStatus field.
+func (r *queryResolver) SyncStatus(ctx context.Context) (*model.SyncStatus, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to view the sync status.")
+	}
+
+	if !auth.IsAdmin(authUser) {
+		return nil, errs.NewForbiddenError(ctx, "You do not have permission to view the sync status.")
+	}
+
+	status, err := r.FileService.GetSyncStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// PruneHistory is the resolver for the pruneHistory field.
+func (r *queryResolver) PruneHistory(ctx context.Context, limit *int) ([]*model.PrunedFile, error) {
+	authUser := auth.GetUserFromContext(ctx)
+	if authUser == nil {
+		return nil, errs.NewUnauthorizedError(ctx, "You must be logged in to view the prune history.")
+	}
+
+	if !auth.IsAdmin(authUser) {
+		return nil, errs.NewForbiddenError(ctx, "You do not have permission to view the prune history.")
+	}
+
+	count := 100
+	if limit != nil {
+		if *limit < 1 {
+			return nil, errs.NewInputError(ctx, "The limit must be at least 1.")
+		}
+		count = *limit
+	}
+
+	prunedFiles, err := r.FileService.GetPruneHistory(ctx, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return prunedFiles, nil
+}
+
+// ExportLink is the resolver for the exportLink field.
+func (r *revisionResolver) ExportLink(ctx context.Context, obj *model.Revision, format model.DownloadFormat) (string, error) {
+	return downloadURL(obj.FileID, &obj.ID, format), nil
+}
+
+// File returns generated.FileResolver implementation.
+func (r *Resolver) File() generated.FileResolver { return &fileResolver{r} }
+
 // Folder returns generated.FolderResolver implementation.
 func (r *Resolver) Folder() generated.FolderResolver { return &folderResolver{r} }
 
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Revision returns generated.RevisionResolver implementation.
+func (r *Resolver) Revision() generated.RevisionResolver { return &revisionResolver{r} }
+
+type fileResolver struct{ *Resolver }
 type folderResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type revisionResolver struct{ *Resolver }
@@ -0,0 +1,45 @@
+package graph
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/auth"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/data"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestSyncStatus(t *testing.T) {
+	// The root folder does not exist, so the sync fails without needing the database
+	service := &data.FileService{Source: &data.LocalSource{Root: filepath.Join(t.TempDir(), "missing")}}
+	service.SyncFiles(context.Background())
+	query := (&Resolver{FileService: service}).Query()
+
+	tests := []struct {
+		name   string
+		user   *auth.AuthUser
+		status int
+	}{
+		{"logged out", nil, 401},
+		{"not an admin", &auth.AuthUser{ID: "1"}, 403},
+		{"inactive admin", &auth.AuthUser{ID: "2", IsAdmin: true, IsInactive: true}, 403},
+	}
+	for _, test := range tests {
+		_, err := query.SyncStatus(auth.WithUser(context.Background(), test.user))
+		if gqlErr, ok := err.(*gqlerror.Error); !ok || gqlErr.Extensions["status"] != test.status {
+			t.Errorf("%s: expected status %d, got %v", test.name, test.status, err)
+		}
+	}
+
+	status, err := query.SyncStatus(auth.WithUser(context.Background(), &auth.AuthUser{ID: "3", IsAdmin: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Running || status.LastRun == nil || status.DurationMs == nil {
+		t.Errorf("expected the time and duration of the finished sync, got %#v", status)
+	}
+	if status.DocumentsUpdated != 0 || status.DocumentsPruned != 0 || len(status.Errors) != 1 {
+		t.Errorf("expected the sync to fail without changing anything, got %#v", status)
+	}
+}
@@ -1,6 +1,12 @@
 package graph
 
-import "git.las.iastate.edu/SeniorDesignComS/2023spr/sop/models"
+import (
+	"net/url"
+	"strings"
+
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/models"
+)
 
 // This file will not be regenerated automatically.
 //
@@ -10,3 +16,14 @@ type Resolver struct {
 	FileService models.FileService
 	UserService models.UserService
 }
+
+// Builds the URL of the download endpoint for a file, optionally at a revision
+func downloadURL(fileId string, revisionId *string, format model.DownloadFormat) string {
+	params := url.Values{}
+	params.Set("format", strings.ToLower(format.String()))
+	if revisionId != nil {
+		params.Set("revision", *revisionId)
+	}
+
+	return "/files/" + url.PathEscape(fileId) + "/download?" + params.Encode()
+}
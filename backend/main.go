@@ -3,9 +3,12 @@ package main
 //go:generate go run github.com/99designs/gqlgen generate
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/auth"
 	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/data"
@@ -42,8 +45,31 @@ func main() {
 	router.Use(auth.Middleware())
 	router.Use(errors.Middleware())
 
+	// Serve SOPs from a local directory instead of Google Drive when configured to
+	var source data.DocumentSource
+	if os.Getenv("DOCUMENT_SOURCE") == "local" {
+		source = &data.LocalSource{Root: os.Getenv("LOCAL_DOCUMENTS_DIR")}
+	} else {
+		driveSource, err := data.NewDriveSource(context.Background(), os.Getenv("GOOGLE_SERVICE_ACCOUNT_KEY_FILE"))
+		if err != nil {
+			log.Fatalf("Could not create the Google Drive client from the key in GOOGLE_SERVICE_ACCOUNT_KEY_FILE: %s", err)
+		}
+		driveSource.BaseURL = os.Getenv("DRIVE_API_URL")
+		driveSource.UploadURL = os.Getenv("DRIVE_UPLOAD_URL")
+		source = driveSource
+	}
+
 	// Create services
-	fileService := &data.FileService{}
+	fileService := &data.FileService{Source: source}
+	if workers, err := strconv.Atoi(os.Getenv("FOLDER_TRAVERSAL_WORKERS")); err == nil {
+		fileService.TraversalWorkers = workers
+	}
+	if maxVersions, err := strconv.Atoi(os.Getenv("SNAPSHOT_MAX_VERSIONS")); err == nil {
+		fileService.SnapshotRetention.MaxVersions = maxVersions
+	}
+	if maxAge, err := time.ParseDuration(os.Getenv("SNAPSHOT_MAX_AGE")); err == nil {
+		fileService.SnapshotRetention.MaxAge = maxAge
+	}
 	userService := &data.UserService{}
 
 	services := models.Services{
@@ -55,6 +81,10 @@ func main() {
 	fileService.Services = services
 	userService.Services = services
 
+	// Keep the search cache up to date in the background
+	syncInterval, _ := time.ParseDuration(os.Getenv("SYNC_INTERVAL"))
+	fileService.StartSync(context.Background(), syncInterval)
+
 	// Attach services to resolvers
 	resolver := &graph.Resolver{
 		FileService: fileService,
@@ -69,6 +99,20 @@ func main() {
 	}
 
 	router.Handle("/api", srv)
+	router.Handle("/files/{id}/download", &data.DownloadHandler{FileService: fileService}).Methods(http.MethodGet)
+	router.Handle("/images/proxy", &data.ImageProxy{}).Methods(http.MethodGet)
+
+	// Sync changes as soon as Drive sends a notification about them
+	if watchSource, ok := source.(data.WatchSource); ok && os.Getenv("DRIVE_WEBHOOK_URL") != "" {
+		watcher := &data.ChangeWatcher{
+			FileService: fileService,
+			Source:      watchSource,
+			Address:     os.Getenv("DRIVE_WEBHOOK_URL"),
+		}
+		watcher.Start(context.Background())
+
+		router.Handle("/drive/notifications", watcher).Methods(http.MethodPost)
+	}
 
 	// Serve React application
 	router.PathPrefix("/static").Handler(http.StripPrefix("/", http.FileServer(http.Dir("./build"))))
@@ -4,14 +4,28 @@ import (
 	"context"
 
 	"git.las.iastate.edu/SeniorDesignComS/2023spr/sop/graph/model"
+	"github.com/99designs/gqlgen/graphql"
 )
 
+// The content of a file converted to a download format
+type FileDownload struct {
+	// The name to save the file as, including the extension
+	FileName string
+
+	MimeType string
+	Content  []byte
+}
+
 type FileService interface {
 	// Gets a list of all folders in the root folder
 	GetAllFolders(ctx context.Context) ([]*model.Folder, error)
 
-	// Gets a list of all contents in a folder
-	GetFolderContents(ctx context.Context, id string) ([]model.FolderItem, error)
+	// Gets a list of the contents of a folder, sorted by the given field and filtered by type and by when items were last modified
+	GetFolderContents(ctx context.Context, id string, sortBy model.FolderSortField, order model.SortOrder, types []model.FolderItemType, modifiedAfter *string) ([]model.FolderItem, error)
+
+	// Gets every item inside a folder from the cached folder tree, or inside the root folder when the folder ID is empty. Items more
+	// than depth levels below the folder are left out, unless depth is 0.
+	GetFolderTree(ctx context.Context, rootId string, depth int) ([]*model.FolderTreeNode, error)
 
 	// Gets a single folder by ID
 	GetFolderById(ctx context.Context, id string) (*model.Folder, error)
@@ -19,9 +33,55 @@ type FileService interface {
 	// Gets a single file by ID
 	GetFileById(ctx context.Context, id string) (*model.File, error)
 
-	// Lists all files sorted by modified date
-	ListFilesByDate(ctx context.Context) ([]*model.File, error)
+	// Gets the folders that contain a file or folder, starting with the folder directly inside the root folder
+	GetPath(ctx context.Context, id string) ([]*model.Folder, error)
+
+	// Gets the folder that contains a file or folder, or nil if it is directly inside the root folder
+	GetParent(ctx context.Context, id string) (*model.Folder, error)
+
+	// Gets a page of files sorted by modified date, most recent first
+	ListFilesByDate(ctx context.Context, first int, after *string) (*model.FileConnection, error)
+
+	// Gets a page of the files with titles or text content containing the given query string, sorted by title
+	SearchFiles(ctx context.Context, query string, first int, after *string) (*model.FileConnection, error)
+
+	// Gets the status of the most recent background sync of the search cache
+	GetSyncStatus(ctx context.Context) (*model.SyncStatus, error)
+
+	// Gets the files most recently removed from the search cache
+	GetPruneHistory(ctx context.Context, limit int) ([]*model.PrunedFile, error)
+
+	// Gets a single file by ID as it was at a revision
+	GetFileRevision(ctx context.Context, id string, revisionId string) (*model.File, error)
+
+	// Gets every revision of a file, most recent first
+	GetFileRevisions(ctx context.Context, id string) ([]*model.Revision, error)
+
+	// Gets every snapshot of a file's text saved in the search cache, most recent first
+	GetFileSnapshots(ctx context.Context, id string) ([]*model.Snapshot, error)
+
+	// Compares the text of two versions of a file
+	DiffFile(ctx context.Context, id string, from string, to string) (*model.FileDiff, error)
+
+	// Gets the content of a file as sanitized HTML. When a revision ID is given, the content is from that revision.
+	GetFileContent(ctx context.Context, id string, revisionId *string) (string, error)
+
+	// Gets the content of a file in the given download format. When a revision ID is given, the content is from that revision.
+	DownloadFile(ctx context.Context, id string, revisionId *string, format model.DownloadFormat) (*FileDownload, error)
+
+	// Uploads a DOCX or PDF file into a folder and adds it to the search cache. When convert is true, a DOCX file is converted to a
+	// Google Doc.
+	UploadFile(ctx context.Context, folderId string, upload graphql.Upload, convert bool) (*model.File, error)
+
+	// Creates a folder inside another folder
+	CreateFolder(ctx context.Context, parentId string, name string) (*model.Folder, error)
+
+	// Renames a folder or file
+	RenameItem(ctx context.Context, id string, name string) (model.FolderItem, error)
+
+	// Moves a folder or file into another folder
+	MoveItem(ctx context.Context, id string, folderId string) (model.FolderItem, error)
 
-	// Searches all files with titles or text content containing the given query string
-	SearchFiles(ctx context.Context, query string) ([]*model.File, error)
+	// Moves a folder or file to the trash and removes its files from the search cache
+	TrashItem(ctx context.Context, id string) (bool, error)
 }
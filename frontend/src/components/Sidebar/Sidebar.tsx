@@ -17,10 +17,19 @@ import { FaSearch, FaSortNumericDown, FaSortAmountDownAlt, FaSortAlphaDown, FaCh
 import { useFloating, offset } from '@floating-ui/react';
 
 const SEARCH_FILE = gql`
-query searchFiles($query: String!) {
-  search(query: $query) {
-    id
-    name
+query searchFiles($query: String!, $after: String) {
+  search(query: $query, first: 50, after: $after) {
+    edges {
+      node {
+        id
+        name
+      }
+    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    totalCount
   }
 }
 `;
@@ -71,12 +80,16 @@ query getAllFolders {
 
 const GET_FILES_BY_DATE = gql`
 query getRecentlyModifiedFiles {
-  listFilesByDate {
-    id
-    name
-    created
-    lastUpdated
-    lastModifiedBy
+  listFilesByDate(first: 20) {
+    edges {
+      node {
+        id
+        name
+        created
+        lastUpdated
+        lastModifiedBy
+      }
+    }
   }
 }
 `;
@@ -89,14 +102,23 @@ type GetAllFoldersResponse = {
   folders: Folder[];
 }
 
+type FileConnection = {
+  edges: { node: File }[];
+  pageInfo: {
+    hasNextPage: boolean;
+    endCursor: string | null;
+  };
+  totalCount: number;
+}
+
 type SearchResult = {
-  search: File[];
+  search: FileConnection;
 } | null;
 
 type FileContentItem = Folder | File;
 
 type GetFilesByDateResponse = {
-  listFilesByDate: File[];
+  listFilesByDate: Pick<FileConnection, 'edges'>;
 }
 
 export type Folder = {
@@ -239,7 +261,7 @@ const Sidebar: React.FunctionComponent = () => {
   const [isOpen, setIsOpen] = useState<boolean>(false);
   const [sortMethod, setSortMethod] = useState<SortMethod>('NAME');
   const [sidebarWidth, setSidebarWidth] = useState<number>(250);
-  const [searchFiles, { data: searchData, loading: searchIsLoading, variables: searchVariables }] = useLazyQuery<SearchResult>(SEARCH_FILE);
+  const [searchFiles, { data: searchData, loading: searchIsLoading, variables: searchVariables, fetchMore: fetchMoreSearchResults }] = useLazyQuery<SearchResult>(SEARCH_FILE);
   const [getFilesByDate, { data: recentFilesData, loading: recentFilesAreLoading }] = useLazyQuery<GetFilesByDateResponse>(GET_FILES_BY_DATE, {
     fetchPolicy: 'network-only',
   });
@@ -259,6 +281,24 @@ const Sidebar: React.FunctionComponent = () => {
     });
   }
 
+  const handleShowMoreResults = async () => {
+    await fetchMoreSearchResults({
+      variables: {
+        after: searchData?.search.pageInfo.endCursor,
+      },
+      updateQuery: (previous, { fetchMoreResult }) => {
+        if (!previous || !fetchMoreResult) return previous;
+
+        return {
+          search: {
+            ...fetchMoreResult.search,
+            edges: [...previous.search.edges, ...fetchMoreResult.search.edges],
+          },
+        };
+      },
+    });
+  }
+
   const searchForm = useForm<SearchInput>({
     initialValues: {
       search: ''
@@ -329,7 +369,7 @@ const Sidebar: React.FunctionComponent = () => {
       }
 
       return (
-        recentFilesData?.listFilesByDate.map((file, index) => {
+        recentFilesData?.listFilesByDate.edges.map(({ node: file }, index) => {
           return (
             <Link to={'/file/' + file.id} className={css(createStyle({ textDecoration: 'none', userSelect: 'none', ...(location.pathname === `/file/${file.id}` ? fileLinkSelected : {}) }))} key={index}>
               <Paragraph style={{ ...fileLinkStyle, fontSize: '14px', marginLeft: '0' }}>{file.name}</Paragraph>
@@ -388,18 +428,23 @@ const Sidebar: React.FunctionComponent = () => {
             </View>
             :
             <View container flexDirection='column' gap='4px' margin='0 0 0 -12px'>
-              {searchData?.search.map((file, index) => {
+              {searchData?.search.edges.map(({ node: file }, index) => {
                 return (
                   <Link to={'/file/' + file.id} className={css(createStyle({ textDecoration: 'none', userSelect: 'none', ...(location.pathname === `/file/${file.id}` ? fileLinkSelected : {}) }))} key={index}>
                     <Paragraph style={{ ...fileLinkStyle, fontSize: '14px' }}>{file.name}</Paragraph>
                   </Link>
                 );
               })}
-              {searchData?.search.length === 0 &&
+              {searchData?.search.totalCount === 0 &&
                 <View container padding='0 16px'>
                   <Paragraph>No results found.</Paragraph>
                 </View>
               }
+              {searchData?.search.pageInfo.hasNextPage &&
+                <View container padding='0 16px' style={{ cursor: 'pointer' }} onClick={handleShowMoreResults}>
+                  <Paragraph>Show more ({searchData.search.totalCount - searchData.search.edges.length} more)</Paragraph>
+                </View>
+              }
             </View>
         }
       </View>